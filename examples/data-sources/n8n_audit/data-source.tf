@@ -0,0 +1,17 @@
+data "n8n_audit" "security" {
+  categories = ["credentials", "nodes", "instance"]
+}
+
+# Write the full report as a build artifact
+resource "local_file" "audit_report" {
+  filename = "${path.module}/n8n-audit.json"
+  content  = data.n8n_audit.security.report
+}
+
+# Fail if any credential risks were found
+check "no_credential_risks" {
+  assert {
+    condition     = length([for f in data.n8n_audit.security.findings : f if f.risk == "credentials"]) == 0
+    error_message = "The n8n security audit reported credential risks."
+  }
+}
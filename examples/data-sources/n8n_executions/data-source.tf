@@ -0,0 +1,12 @@
+data "n8n_executions" "recent" {
+  workflow_id = n8n_workflow.example.id
+  limit       = 5
+}
+
+# Fail the pipeline if all of the last five executions errored
+check "workflow_healthy" {
+  assert {
+    condition     = length(data.n8n_executions.recent.executions) == 0 || anytrue([for e in data.n8n_executions.recent.executions : e.status != "error"])
+    error_message = "The last executions of the workflow all failed."
+  }
+}
@@ -0,0 +1,12 @@
+data "n8n_insights_summary" "last_week" {
+  start_date = timeadd(plantimestamp(), "-168h")
+  end_date   = plantimestamp()
+}
+
+output "weekly_executions" {
+  value = data.n8n_insights_summary.last_week.total_executions
+}
+
+output "weekly_failure_rate" {
+  value = data.n8n_insights_summary.last_week.failure_rate
+}
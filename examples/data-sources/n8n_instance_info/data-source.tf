@@ -0,0 +1,11 @@
+data "n8n_instance_info" "this" {
+  min_version = "1.80.0"
+}
+
+# Only configure SAML where the feature is licensed
+resource "n8n_saml_config" "this" {
+  count = data.n8n_instance_info.this.features.saml ? 1 : 0
+
+  metadata_url  = "https://idp.example.com/metadata"
+  login_enabled = true
+}
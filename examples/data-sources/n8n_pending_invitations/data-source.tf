@@ -0,0 +1,10 @@
+data "n8n_pending_invitations" "all" {}
+
+output "pending_emails" {
+  value = [for i in data.n8n_pending_invitations.all.invitations : i.email]
+}
+
+output "invite_links" {
+  value     = { for i in data.n8n_pending_invitations.all.invitations : i.email => i.invite_accept_url }
+  sensitive = true
+}
@@ -0,0 +1,12 @@
+data "n8n_project" "marketing" {
+  name = "Marketing"
+}
+
+resource "n8n_credential" "hubspot" {
+  name       = "HubSpot"
+  type       = "hubspotApi"
+  project_id = data.n8n_project.marketing.id
+  data = jsonencode({
+    apiKey = var.hubspot_api_key
+  })
+}
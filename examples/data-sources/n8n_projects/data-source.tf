@@ -0,0 +1,5 @@
+data "n8n_projects" "all" {}
+
+output "team_project_ids" {
+  value = [for p in data.n8n_projects.all.projects : p.id if p.type == "team"]
+}
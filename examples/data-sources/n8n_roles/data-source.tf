@@ -0,0 +1,7 @@
+data "n8n_roles" "project" {
+  role_type = "project"
+}
+
+output "project_roles" {
+  value = [for role in data.n8n_roles.project.roles : role.slug]
+}
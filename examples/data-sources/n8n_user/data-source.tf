@@ -21,3 +21,8 @@ output "is_owner" {
 output "member_invite_url" {
   value = data.n8n_user.example.member_invite_url
 }
+
+# Look up a user by email address instead of ID
+data "n8n_user" "by_email" {
+  email = "jane.doe@example.com"
+}
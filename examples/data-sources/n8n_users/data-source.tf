@@ -0,0 +1,10 @@
+data "n8n_users" "all" {}
+
+# Add every active user to a project as an editor
+resource "n8n_project_membership" "everyone" {
+  for_each = { for u in data.n8n_users.all.users : u.id => u if !u.is_pending && !u.is_owner }
+
+  project_id = "VmwOO9HeTEj20kxM"
+  user_id    = each.key
+  role       = "project:editor"
+}
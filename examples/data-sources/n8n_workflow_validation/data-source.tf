@@ -0,0 +1,15 @@
+data "n8n_workflow_validation" "example" {
+  workflow_json    = file("${path.module}/workflows/some-workflow.json")
+  check_activation = true
+}
+
+resource "n8n_workflow" "example" {
+  workflow_json = file("${path.module}/workflows/some-workflow.json")
+
+  lifecycle {
+    precondition {
+      condition     = data.n8n_workflow_validation.example.valid
+      error_message = join("\n", data.n8n_workflow_validation.example.errors)
+    }
+  }
+}
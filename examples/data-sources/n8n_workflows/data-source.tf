@@ -0,0 +1,13 @@
+# All active workflows tagged "production"
+data "n8n_workflows" "production" {
+  active = true
+  tags   = ["production"]
+}
+
+# Keep every production workflow active
+resource "n8n_workflow_activation" "production" {
+  for_each = { for w in data.n8n_workflows.production.workflows : w.id => w }
+
+  workflow_id = each.key
+  active      = true
+}
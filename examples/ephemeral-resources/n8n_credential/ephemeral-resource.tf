@@ -0,0 +1,11 @@
+# A credential that only exists while Terraform runs, for example for a
+# deployment workflow executed as part of the apply
+ephemeral "n8n_credential" "ci_token" {
+  name = "CI deploy token (${var.run_id})"
+  type = "httpHeaderAuth"
+
+  data = jsonencode({
+    name  = "Authorization"
+    value = "Bearer ${var.ci_token}"
+  })
+}
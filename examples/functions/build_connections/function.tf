@@ -0,0 +1,12 @@
+resource "n8n_workflow" "example" {
+  name = "Webhook to Slack"
+
+  nodes = jsonencode([
+    # ... Webhook, Set and Slack nodes ...
+  ])
+
+  # Webhook -> Set -> Slack
+  connections = provider::n8n::build_connections([
+    ["Webhook", "Set", "Slack"],
+  ])
+}
@@ -0,0 +1,2 @@
+# API keys can be imported using their ID; the key value cannot be recovered
+terraform import n8n_api_key.ci 0b6d1f0c-6e5d-4c3e-8f2b-7c9a4e1d2f3a
@@ -0,0 +1,14 @@
+resource "n8n_api_key" "ci" {
+  label  = "CI pipeline"
+  scopes = ["workflow:read", "workflow:list", "execution:read"]
+
+  # Rotate the key by changing this value
+  keepers = {
+    rotation = "2025-q1"
+  }
+}
+
+output "ci_api_key" {
+  value     = n8n_api_key.ci.api_key
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Community packages can be imported using the package name
+terraform import n8n_community_package.example n8n-nodes-example
@@ -0,0 +1,9 @@
+resource "n8n_community_package" "example" {
+  package_name = "n8n-nodes-example"
+  version      = "1.2.0"
+}
+
+# Reference the node types provided by the package in a workflow
+output "example_node_types" {
+  value = n8n_community_package.example.node_types
+}
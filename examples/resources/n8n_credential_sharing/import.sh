@@ -0,0 +1,2 @@
+# Credential sharing can be imported using the credential ID
+terraform import n8n_credential_sharing.slack R5OLzwbI9nMV1Ix7
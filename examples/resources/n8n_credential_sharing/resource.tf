@@ -0,0 +1,7 @@
+resource "n8n_credential_sharing" "slack" {
+  credential_id = n8n_credential.slack.id
+  project_ids = [
+    var.marketing_project_id,
+    var.support_project_id,
+  ]
+}
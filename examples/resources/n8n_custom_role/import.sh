@@ -0,0 +1,2 @@
+# Custom roles can be imported using their slug
+terraform import n8n_custom_role.workflow_operator project:workflow-operator
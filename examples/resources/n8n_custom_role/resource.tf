@@ -0,0 +1,19 @@
+resource "n8n_custom_role" "workflow_operator" {
+  display_name = "Workflow Operator"
+  description  = "Can run and activate workflows but not edit them"
+  role_type    = "project"
+
+  scopes = [
+    "workflow:read",
+    "workflow:list",
+    "workflow:execute",
+    "workflow:activate",
+  ]
+}
+
+# Grant the custom role in a project
+resource "n8n_project_membership" "operator" {
+  project_id = var.project_id
+  user_id    = var.user_id
+  role       = n8n_custom_role.workflow_operator.id
+}
@@ -0,0 +1,13 @@
+# Delete failed executions older than two weeks on every maintenance run
+resource "n8n_execution_prune" "failed" {
+  older_than_days = 14
+  status          = "error"
+
+  triggers = {
+    run = timestamp()
+  }
+}
+
+output "pruned_executions" {
+  value = n8n_execution_prune.failed.deleted_count
+}
@@ -0,0 +1,2 @@
+# Folders can be imported using project_id:folder_id
+terraform import n8n_folder.integrations VmwOO9HeTEj20kxM:a1b2c3d4-e5f6-7890-abcd-ef1234567890
@@ -0,0 +1,28 @@
+resource "n8n_folder" "integrations" {
+  project_id = "VmwOO9HeTEj20kxM"
+  name       = "Integrations"
+}
+
+resource "n8n_folder" "crm" {
+  project_id       = n8n_folder.integrations.project_id
+  name             = "CRM"
+  parent_folder_id = n8n_folder.integrations.id
+}
+
+resource "n8n_workflow" "sync_contacts" {
+  name       = "Sync Contacts"
+  project_id = n8n_folder.crm.project_id
+  folder_id  = n8n_folder.crm.id
+
+  nodes = jsonencode([
+    {
+      id          = "1"
+      name        = "Schedule Trigger"
+      type        = "n8n-nodes-base.scheduleTrigger"
+      typeVersion = 1.2
+      position    = [250, 300]
+      parameters  = {}
+    }
+  ])
+  connections = jsonencode({})
+}
@@ -0,0 +1,2 @@
+# The instance settings are a singleton; any ID can be used
+terraform import n8n_instance_settings.this instance-settings
@@ -0,0 +1,6 @@
+resource "n8n_instance_settings" "this" {
+  timezone                       = "Europe/Berlin"
+  telemetry_enabled              = false
+  personalization_survey_enabled = false
+  dismissed_banners              = ["V1", "NON_PRODUCTION_LICENSE"]
+}
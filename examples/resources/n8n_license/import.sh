@@ -0,0 +1,3 @@
+# The license is a singleton; any ID can be used. Set activation_key in the
+# configuration to match the key that is already active.
+terraform import n8n_license.this license
@@ -0,0 +1,12 @@
+variable "n8n_license_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "n8n_license" "this" {
+  activation_key = var.n8n_license_key
+}
+
+output "n8n_plan" {
+  value = n8n_license.this.plan_name
+}
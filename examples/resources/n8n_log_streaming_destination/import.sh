@@ -0,0 +1,2 @@
+# Import a log streaming destination by ID
+terraform import n8n_log_streaming_destination.siem 0a8f1a3c-5c4e-4f7d-9a3f-6a3cf1c1b2d4
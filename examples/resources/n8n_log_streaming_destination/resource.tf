@@ -0,0 +1,34 @@
+# Forward audit events to a SIEM webhook
+resource "n8n_log_streaming_destination" "siem" {
+  label             = "SIEM"
+  subscribed_events = ["n8n.audit"]
+
+  webhook = {
+    url = "https://siem.example.com/ingest/n8n"
+    headers = {
+      Authorization = "Bearer ${var.siem_token}"
+    }
+  }
+}
+
+# Send workflow failures to syslog
+resource "n8n_log_streaming_destination" "syslog" {
+  label             = "Syslog"
+  subscribed_events = ["n8n.workflow.failed", "n8n.node.failed"]
+
+  syslog = {
+    host     = "syslog.internal"
+    protocol = "tcp"
+  }
+}
+
+# Report errors to Sentry
+resource "n8n_log_streaming_destination" "sentry" {
+  label                    = "Sentry"
+  subscribed_events        = ["n8n.workflow"]
+  anonymize_audit_messages = true
+
+  sentry = {
+    dsn = var.sentry_dsn
+  }
+}
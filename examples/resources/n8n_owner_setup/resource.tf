@@ -0,0 +1,23 @@
+# Bootstrap a freshly installed instance. No api_key is needed for this provider configuration.
+provider "n8n" {
+  alias    = "bootstrap"
+  endpoint = "https://n8n.example.com"
+}
+
+resource "n8n_owner_setup" "this" {
+  provider = n8n.bootstrap
+
+  email      = "admin@example.com"
+  first_name = "Admin"
+  last_name  = "User"
+  password   = var.owner_password
+
+  api_key_label = "terraform"
+}
+
+# Manage everything else with the API key created during setup.
+# Run `terraform apply -target=n8n_owner_setup.this` first so the key is known.
+provider "n8n" {
+  endpoint = "https://n8n.example.com"
+  api_key  = n8n_owner_setup.this.api_key
+}
@@ -0,0 +1,2 @@
+# Project memberships can be imported using project_id:user_id
+terraform import n8n_project_membership.analyst Wd9ZlVIq5tgVn6ig:8efb6ce5-3e21-4835-9d32-4135e870e912
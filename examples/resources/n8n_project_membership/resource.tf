@@ -0,0 +1,9 @@
+resource "n8n_user" "analyst" {
+  email = "analyst@example.com"
+}
+
+resource "n8n_project_membership" "analyst" {
+  project_id = "Wd9ZlVIq5tgVn6ig"
+  user_id    = n8n_user.analyst.id
+  role       = "project:viewer"
+}
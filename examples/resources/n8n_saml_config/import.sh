@@ -0,0 +1,2 @@
+# The SAML configuration is a singleton; any ID can be used
+terraform import n8n_saml_config.this saml
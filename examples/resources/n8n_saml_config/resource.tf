@@ -0,0 +1,15 @@
+resource "n8n_saml_config" "this" {
+  metadata_url  = "https://login.example.com/app/n8n/sso/saml/metadata"
+  login_enabled = true
+  login_label   = "Sign in with Example SSO"
+
+  attribute_mapping = {
+    email      = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+    first_name = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname"
+    last_name  = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname"
+  }
+}
+
+output "saml_acs_url" {
+  value = n8n_saml_config.this.return_url
+}
@@ -0,0 +1,2 @@
+# The source control configuration is a singleton; any ID can be used
+terraform import n8n_source_control.this source-control
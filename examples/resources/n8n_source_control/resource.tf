@@ -0,0 +1,15 @@
+resource "n8n_source_control" "this" {
+  repository_url     = "git@github.com:example-org/n8n-environments.git"
+  branch_name        = "production"
+  branch_read_only   = true
+  branch_color       = "#F45959"
+  key_generator_type = "ed25519"
+}
+
+# Register the generated key with the Git host, e.g. with the GitHub provider
+resource "github_repository_deploy_key" "n8n" {
+  title      = "n8n production"
+  repository = "n8n-environments"
+  key        = n8n_source_control.this.public_key
+  read_only  = true
+}
@@ -0,0 +1,18 @@
+variable "release_sha" {
+  type        = string
+  description = "Commit of the environments repository to deploy"
+}
+
+resource "n8n_source_control_pull" "release" {
+  force = true
+
+  triggers = {
+    sha = var.release_sha
+  }
+
+  depends_on = [n8n_source_control.this]
+}
+
+output "pulled_workflows" {
+  value = [for w in n8n_source_control_pull.release.workflows : w.name]
+}
@@ -11,6 +11,31 @@ output "member_invite_url" {
   sensitive   = true
 }
 
+# Invite a user again once their invitation is older than a week
+resource "n8n_user" "contractor" {
+  email          = "contractor@example.com"
+  invitation_ttl = "168h"
+
+  # Change to send a new invitation
+  resend_invitation = "2024-06-01"
+}
+
+# Wait until the user has accepted the invitation before depending resources
+# are created
+resource "n8n_user" "operator" {
+  email = "operator@example.com"
+
+  wait_for_acceptance        = true
+  acceptance_timeout_seconds = 1800
+}
+
+# Hand the workflows and credentials of the user over to the admin when the
+# user is removed
+resource "n8n_user" "departing" {
+  email               = "departing@example.com"
+  transfer_to_user_id = n8n_user.admin.id
+}
+
 # Create an admin user
 resource "n8n_user" "admin" {
   email = "admin@example.com"
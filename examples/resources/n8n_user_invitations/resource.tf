@@ -0,0 +1,14 @@
+resource "n8n_user_invitations" "team" {
+  users = {
+    "alice@example.com" = {
+      role = "global:admin"
+    }
+    "bob@example.com"   = {}
+    "carol@example.com" = {}
+  }
+}
+
+output "invite_urls" {
+  value     = { for email, user in n8n_user_invitations.team.users : email => user.invite_accept_url }
+  sensitive = true
+}
@@ -50,11 +50,22 @@ resource "n8n_workflow" "example" {
   settings = jsonencode({
     executionOrder = "v1"
   })
+
+  active = true
 }
 
 # Example 2: Using workflow_json with file() function (recommended for complex workflows)
 resource "n8n_workflow" "from_file" {
   workflow_json = file("${path.module}/workflows/some-workflow.json")
+
+  tag_names           = ["production", "billing"]
+  create_missing_tags = true
+
+  # Large workflows on a slow instance can take longer than 30 seconds
+  timeouts {
+    create = "5m"
+    update = "5m"
+  }
 }
 
 # Example 3: Using workflow_json with jsondecode for dynamic values
@@ -70,3 +81,93 @@ resource "n8n_workflow" "dynamic" {
     }
   ))
 }
+
+# Example 4: Defining the workflow in HCL with node and connect blocks
+resource "n8n_workflow" "hcl" {
+  name = "Webhook to Slack"
+
+  node {
+    name         = "Webhook"
+    type         = "n8n-nodes-base.webhook"
+    type_version = 2
+    parameters = jsonencode({
+      path       = "incoming"
+      httpMethod = "POST"
+    })
+  }
+
+  node {
+    name         = "Slack"
+    type         = "n8n-nodes-base.slack"
+    type_version = 2.2
+    parameters = jsonencode({
+      text = "={{ $json.body.message }}"
+    })
+  }
+
+  connect {
+    from = "Webhook"
+    to   = "Slack"
+  }
+}
+
+output "slack_webhook_url" {
+  value = n8n_workflow.hcl.webhook_urls[0].production_url
+}
+
+# Example 5: AI agent with credentials and sub-node connections in HCL
+resource "n8n_workflow" "agent" {
+  name = "Support Agent"
+
+  node {
+    name         = "Chat Trigger"
+    type         = "@n8n/n8n-nodes-langchain.chatTrigger"
+    type_version = 1.1
+  }
+
+  node {
+    name         = "Agent"
+    type         = "@n8n/n8n-nodes-langchain.agent"
+    type_version = 1.7
+  }
+
+  node {
+    name         = "OpenAI Chat Model"
+    type         = "@n8n/n8n-nodes-langchain.lmChatOpenAi"
+    type_version = 1.2
+    credentials = {
+      openAiApi = n8n_credential.openai.id
+    }
+  }
+
+  connect {
+    from = "Chat Trigger"
+    to   = "Agent"
+  }
+
+  connect {
+    from = "OpenAI Chat Model"
+    to   = "Agent"
+    type = "ai_languageModel"
+  }
+}
+
+# Example 6: Promoting an export between instances by remapping its credentials
+resource "n8n_workflow" "promoted" {
+  workflow_json = file("${path.module}/workflows/some-workflow.json")
+
+  credential_mappings = {
+    # Credential name (or ID) used in the export => credential on this instance
+    "Slack account" = n8n_credential.slack.id
+  }
+}
+
+# Example 7: One export for every environment, with ${var:NAME} placeholders
+# such as "channel": "${var:SLACK_CHANNEL}" in the JSON file
+resource "n8n_workflow" "templated" {
+  workflow_json = file("${path.module}/workflows/alerts.json")
+
+  template_vars = {
+    SLACK_CHANNEL = var.environment == "prod" ? "#alerts" : "#alerts-${var.environment}"
+  }
+}
@@ -1,4 +1,11 @@
 resource "n8n_workflow_activation" "example" {
   workflow_id = n8n_workflow.example.id
   active      = true
-}
\ No newline at end of file
+}
+
+# Fail the plan instead of reactivating when the workflow is toggled off in n8n
+resource "n8n_workflow_activation" "strict" {
+  workflow_id = n8n_workflow.critical.id
+  active      = true
+  on_drift    = "error"
+}
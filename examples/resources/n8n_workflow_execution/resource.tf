@@ -0,0 +1,14 @@
+# Run the workflow after every change to smoke-test the deployment
+resource "n8n_workflow_execution" "smoke_test" {
+  workflow_id = n8n_workflow.example.id
+
+  triggers = {
+    updated_at = n8n_workflow.example.updated_at
+  }
+
+  timeout_seconds = 120
+}
+
+output "smoke_test_output" {
+  value = jsondecode(n8n_workflow_execution.smoke_test.output)
+}
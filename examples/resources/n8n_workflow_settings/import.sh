@@ -0,0 +1,2 @@
+# Workflow settings can be imported using the workflow ID
+terraform import n8n_workflow_settings.example 2tUt1wbLX592XDdX
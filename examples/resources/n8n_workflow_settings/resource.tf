@@ -0,0 +1,10 @@
+resource "n8n_workflow_settings" "example" {
+  workflow_id = n8n_workflow.example.id
+
+  error_workflow            = n8n_workflow.error_handler.id
+  timezone                  = "Europe/Berlin"
+  execution_order           = "v1"
+  save_data_error_execution = "all"
+  save_manual_executions    = false
+  execution_timeout         = 600
+}
@@ -0,0 +1,2 @@
+# Workflow tags can be imported using the workflow ID
+terraform import n8n_workflow_tags.example 2tUt1wbLX592XDdX
@@ -0,0 +1,4 @@
+resource "n8n_workflow_tags" "example" {
+  workflow_id = n8n_workflow.example.id
+  tag_ids     = ["Q0YO0Nm5ytB6QOIu", "ZqQ9WWzt2pW1zyLs"]
+}
@@ -0,0 +1,639 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Defines values for WorkflowSettingsCallerPolicy.
+const (
+	WorkflowSettingsCallerPolicyAny                    WorkflowSettingsCallerPolicy = "any"
+	WorkflowSettingsCallerPolicyNone                   WorkflowSettingsCallerPolicy = "none"
+	WorkflowSettingsCallerPolicyWorkflowsFromAList     WorkflowSettingsCallerPolicy = "workflowsFromAList"
+	WorkflowSettingsCallerPolicyWorkflowsFromSameOwner WorkflowSettingsCallerPolicy = "workflowsFromSameOwner"
+)
+
+// Defines values for WorkflowSettingsSaveDataErrorExecution.
+const (
+	WorkflowSettingsSaveDataErrorExecutionAll  WorkflowSettingsSaveDataErrorExecution = "all"
+	WorkflowSettingsSaveDataErrorExecutionNone WorkflowSettingsSaveDataErrorExecution = "none"
+)
+
+// Defines values for WorkflowSettingsSaveDataSuccessExecution.
+const (
+	All  WorkflowSettingsSaveDataSuccessExecution = "all"
+	None WorkflowSettingsSaveDataSuccessExecution = "none"
+)
+
+// Node defines model for Node.
+type Node struct {
+	AlwaysOutputData *bool `json:"alwaysOutputData,omitempty"`
+
+	// ContinueOnFail Replaced by onError in newer n8n versions
+	ContinueOnFail       *bool                   `json:"continueOnFail,omitempty"`
+	Credentials          *map[string]interface{} `json:"credentials,omitempty"`
+	Disabled             *bool                   `json:"disabled,omitempty"`
+	ExecuteOnce          *bool                   `json:"executeOnce,omitempty"`
+	Id                   *NodeID                 `json:"id,omitempty"`
+	MaxTries             *float64                `json:"maxTries,omitempty"`
+	Name                 *string                 `json:"name,omitempty"`
+	Notes                *string                 `json:"notes,omitempty"`
+	NotesInFlow          *bool                   `json:"notesInFlow,omitempty"`
+	OnError              *string                 `json:"onError,omitempty"`
+	Parameters           *map[string]interface{} `json:"parameters,omitempty"`
+	Position             *[]float64              `json:"position,omitempty"`
+	RetryOnFail          *bool                   `json:"retryOnFail,omitempty"`
+	Type                 *string                 `json:"type,omitempty"`
+	TypeVersion          *float64                `json:"typeVersion,omitempty"`
+	WaitBetweenTries     *float64                `json:"waitBetweenTries,omitempty"`
+	WebhookId            *string                 `json:"webhookId,omitempty"`
+	AdditionalProperties map[string]interface{}  `json:"-"`
+}
+
+// WorkflowRequest Body of the requests that create or replace a workflow
+type WorkflowRequest struct {
+	Connections map[string]interface{} `json:"connections"`
+	Name        string                 `json:"name"`
+	Nodes       []Node                 `json:"nodes"`
+
+	// ParentFolderId Folder the workflow is moved to
+	ParentFolderId *string `json:"parentFolderId,omitempty"`
+
+	// PinData Output pinned to nodes in the editor, keyed by node name
+	PinData *map[string]interface{} `json:"pinData,omitempty"`
+
+	// ProjectId Project a new workflow is created in
+	ProjectId *string           `json:"projectId,omitempty"`
+	Settings  *WorkflowSettings `json:"settings,omitempty"`
+}
+
+// WorkflowSettings defines model for WorkflowSettings.
+type WorkflowSettings struct {
+	CallerIds    *string                       `json:"callerIds,omitempty"`
+	CallerPolicy *WorkflowSettingsCallerPolicy `json:"callerPolicy,omitempty"`
+
+	// ErrorWorkflow ID of the workflow that runs when this workflow fails
+	ErrorWorkflow            *string                                   `json:"errorWorkflow,omitempty"`
+	ExecutionOrder           *string                                   `json:"executionOrder,omitempty"`
+	ExecutionTimeout         *float64                                  `json:"executionTimeout,omitempty"`
+	SaveDataErrorExecution   *WorkflowSettingsSaveDataErrorExecution   `json:"saveDataErrorExecution,omitempty"`
+	SaveDataSuccessExecution *WorkflowSettingsSaveDataSuccessExecution `json:"saveDataSuccessExecution,omitempty"`
+	SaveExecutionProgress    *bool                                     `json:"saveExecutionProgress,omitempty"`
+	SaveManualExecutions     *bool                                     `json:"saveManualExecutions,omitempty"`
+	TimeSavedPerExecution    *float64                                  `json:"timeSavedPerExecution,omitempty"`
+	Timezone                 *string                                   `json:"timezone,omitempty"`
+	AdditionalProperties     map[string]interface{}                    `json:"-"`
+}
+
+// WorkflowSettingsCallerPolicy defines model for WorkflowSettings.CallerPolicy.
+type WorkflowSettingsCallerPolicy string
+
+// WorkflowSettingsSaveDataErrorExecution defines model for WorkflowSettings.SaveDataErrorExecution.
+type WorkflowSettingsSaveDataErrorExecution string
+
+// WorkflowSettingsSaveDataSuccessExecution defines model for WorkflowSettings.SaveDataSuccessExecution.
+type WorkflowSettingsSaveDataSuccessExecution string
+
+// Getter for additional properties for Node. Returns the specified
+// element and whether it was found
+func (a Node) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Node
+func (a *Node) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Node to handle AdditionalProperties
+func (a *Node) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["alwaysOutputData"]; found {
+		err = json.Unmarshal(raw, &a.AlwaysOutputData)
+		if err != nil {
+			return fmt.Errorf("error reading 'alwaysOutputData': %w", err)
+		}
+		delete(object, "alwaysOutputData")
+	}
+
+	if raw, found := object["continueOnFail"]; found {
+		err = json.Unmarshal(raw, &a.ContinueOnFail)
+		if err != nil {
+			return fmt.Errorf("error reading 'continueOnFail': %w", err)
+		}
+		delete(object, "continueOnFail")
+	}
+
+	if raw, found := object["credentials"]; found {
+		err = json.Unmarshal(raw, &a.Credentials)
+		if err != nil {
+			return fmt.Errorf("error reading 'credentials': %w", err)
+		}
+		delete(object, "credentials")
+	}
+
+	if raw, found := object["disabled"]; found {
+		err = json.Unmarshal(raw, &a.Disabled)
+		if err != nil {
+			return fmt.Errorf("error reading 'disabled': %w", err)
+		}
+		delete(object, "disabled")
+	}
+
+	if raw, found := object["executeOnce"]; found {
+		err = json.Unmarshal(raw, &a.ExecuteOnce)
+		if err != nil {
+			return fmt.Errorf("error reading 'executeOnce': %w", err)
+		}
+		delete(object, "executeOnce")
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if raw, found := object["maxTries"]; found {
+		err = json.Unmarshal(raw, &a.MaxTries)
+		if err != nil {
+			return fmt.Errorf("error reading 'maxTries': %w", err)
+		}
+		delete(object, "maxTries")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["notes"]; found {
+		err = json.Unmarshal(raw, &a.Notes)
+		if err != nil {
+			return fmt.Errorf("error reading 'notes': %w", err)
+		}
+		delete(object, "notes")
+	}
+
+	if raw, found := object["notesInFlow"]; found {
+		err = json.Unmarshal(raw, &a.NotesInFlow)
+		if err != nil {
+			return fmt.Errorf("error reading 'notesInFlow': %w", err)
+		}
+		delete(object, "notesInFlow")
+	}
+
+	if raw, found := object["onError"]; found {
+		err = json.Unmarshal(raw, &a.OnError)
+		if err != nil {
+			return fmt.Errorf("error reading 'onError': %w", err)
+		}
+		delete(object, "onError")
+	}
+
+	if raw, found := object["parameters"]; found {
+		err = json.Unmarshal(raw, &a.Parameters)
+		if err != nil {
+			return fmt.Errorf("error reading 'parameters': %w", err)
+		}
+		delete(object, "parameters")
+	}
+
+	if raw, found := object["position"]; found {
+		err = json.Unmarshal(raw, &a.Position)
+		if err != nil {
+			return fmt.Errorf("error reading 'position': %w", err)
+		}
+		delete(object, "position")
+	}
+
+	if raw, found := object["retryOnFail"]; found {
+		err = json.Unmarshal(raw, &a.RetryOnFail)
+		if err != nil {
+			return fmt.Errorf("error reading 'retryOnFail': %w", err)
+		}
+		delete(object, "retryOnFail")
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
+
+	if raw, found := object["typeVersion"]; found {
+		err = json.Unmarshal(raw, &a.TypeVersion)
+		if err != nil {
+			return fmt.Errorf("error reading 'typeVersion': %w", err)
+		}
+		delete(object, "typeVersion")
+	}
+
+	if raw, found := object["waitBetweenTries"]; found {
+		err = json.Unmarshal(raw, &a.WaitBetweenTries)
+		if err != nil {
+			return fmt.Errorf("error reading 'waitBetweenTries': %w", err)
+		}
+		delete(object, "waitBetweenTries")
+	}
+
+	if raw, found := object["webhookId"]; found {
+		err = json.Unmarshal(raw, &a.WebhookId)
+		if err != nil {
+			return fmt.Errorf("error reading 'webhookId': %w", err)
+		}
+		delete(object, "webhookId")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Node to handle AdditionalProperties
+func (a Node) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.AlwaysOutputData != nil {
+		object["alwaysOutputData"], err = json.Marshal(a.AlwaysOutputData)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'alwaysOutputData': %w", err)
+		}
+	}
+
+	if a.ContinueOnFail != nil {
+		object["continueOnFail"], err = json.Marshal(a.ContinueOnFail)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'continueOnFail': %w", err)
+		}
+	}
+
+	if a.Credentials != nil {
+		object["credentials"], err = json.Marshal(a.Credentials)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'credentials': %w", err)
+		}
+	}
+
+	if a.Disabled != nil {
+		object["disabled"], err = json.Marshal(a.Disabled)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'disabled': %w", err)
+		}
+	}
+
+	if a.ExecuteOnce != nil {
+		object["executeOnce"], err = json.Marshal(a.ExecuteOnce)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'executeOnce': %w", err)
+		}
+	}
+
+	if a.Id != nil {
+		object["id"], err = json.Marshal(a.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'id': %w", err)
+		}
+	}
+
+	if a.MaxTries != nil {
+		object["maxTries"], err = json.Marshal(a.MaxTries)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'maxTries': %w", err)
+		}
+	}
+
+	if a.Name != nil {
+		object["name"], err = json.Marshal(a.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		}
+	}
+
+	if a.Notes != nil {
+		object["notes"], err = json.Marshal(a.Notes)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'notes': %w", err)
+		}
+	}
+
+	if a.NotesInFlow != nil {
+		object["notesInFlow"], err = json.Marshal(a.NotesInFlow)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'notesInFlow': %w", err)
+		}
+	}
+
+	if a.OnError != nil {
+		object["onError"], err = json.Marshal(a.OnError)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'onError': %w", err)
+		}
+	}
+
+	if a.Parameters != nil {
+		object["parameters"], err = json.Marshal(a.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'parameters': %w", err)
+		}
+	}
+
+	if a.Position != nil {
+		object["position"], err = json.Marshal(a.Position)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'position': %w", err)
+		}
+	}
+
+	if a.RetryOnFail != nil {
+		object["retryOnFail"], err = json.Marshal(a.RetryOnFail)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'retryOnFail': %w", err)
+		}
+	}
+
+	if a.Type != nil {
+		object["type"], err = json.Marshal(a.Type)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'type': %w", err)
+		}
+	}
+
+	if a.TypeVersion != nil {
+		object["typeVersion"], err = json.Marshal(a.TypeVersion)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'typeVersion': %w", err)
+		}
+	}
+
+	if a.WaitBetweenTries != nil {
+		object["waitBetweenTries"], err = json.Marshal(a.WaitBetweenTries)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'waitBetweenTries': %w", err)
+		}
+	}
+
+	if a.WebhookId != nil {
+		object["webhookId"], err = json.Marshal(a.WebhookId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'webhookId': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for WorkflowSettings. Returns the specified
+// element and whether it was found
+func (a WorkflowSettings) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for WorkflowSettings
+func (a *WorkflowSettings) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for WorkflowSettings to handle AdditionalProperties
+func (a *WorkflowSettings) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["callerIds"]; found {
+		err = json.Unmarshal(raw, &a.CallerIds)
+		if err != nil {
+			return fmt.Errorf("error reading 'callerIds': %w", err)
+		}
+		delete(object, "callerIds")
+	}
+
+	if raw, found := object["callerPolicy"]; found {
+		err = json.Unmarshal(raw, &a.CallerPolicy)
+		if err != nil {
+			return fmt.Errorf("error reading 'callerPolicy': %w", err)
+		}
+		delete(object, "callerPolicy")
+	}
+
+	if raw, found := object["errorWorkflow"]; found {
+		err = json.Unmarshal(raw, &a.ErrorWorkflow)
+		if err != nil {
+			return fmt.Errorf("error reading 'errorWorkflow': %w", err)
+		}
+		delete(object, "errorWorkflow")
+	}
+
+	if raw, found := object["executionOrder"]; found {
+		err = json.Unmarshal(raw, &a.ExecutionOrder)
+		if err != nil {
+			return fmt.Errorf("error reading 'executionOrder': %w", err)
+		}
+		delete(object, "executionOrder")
+	}
+
+	if raw, found := object["executionTimeout"]; found {
+		err = json.Unmarshal(raw, &a.ExecutionTimeout)
+		if err != nil {
+			return fmt.Errorf("error reading 'executionTimeout': %w", err)
+		}
+		delete(object, "executionTimeout")
+	}
+
+	if raw, found := object["saveDataErrorExecution"]; found {
+		err = json.Unmarshal(raw, &a.SaveDataErrorExecution)
+		if err != nil {
+			return fmt.Errorf("error reading 'saveDataErrorExecution': %w", err)
+		}
+		delete(object, "saveDataErrorExecution")
+	}
+
+	if raw, found := object["saveDataSuccessExecution"]; found {
+		err = json.Unmarshal(raw, &a.SaveDataSuccessExecution)
+		if err != nil {
+			return fmt.Errorf("error reading 'saveDataSuccessExecution': %w", err)
+		}
+		delete(object, "saveDataSuccessExecution")
+	}
+
+	if raw, found := object["saveExecutionProgress"]; found {
+		err = json.Unmarshal(raw, &a.SaveExecutionProgress)
+		if err != nil {
+			return fmt.Errorf("error reading 'saveExecutionProgress': %w", err)
+		}
+		delete(object, "saveExecutionProgress")
+	}
+
+	if raw, found := object["saveManualExecutions"]; found {
+		err = json.Unmarshal(raw, &a.SaveManualExecutions)
+		if err != nil {
+			return fmt.Errorf("error reading 'saveManualExecutions': %w", err)
+		}
+		delete(object, "saveManualExecutions")
+	}
+
+	if raw, found := object["timeSavedPerExecution"]; found {
+		err = json.Unmarshal(raw, &a.TimeSavedPerExecution)
+		if err != nil {
+			return fmt.Errorf("error reading 'timeSavedPerExecution': %w", err)
+		}
+		delete(object, "timeSavedPerExecution")
+	}
+
+	if raw, found := object["timezone"]; found {
+		err = json.Unmarshal(raw, &a.Timezone)
+		if err != nil {
+			return fmt.Errorf("error reading 'timezone': %w", err)
+		}
+		delete(object, "timezone")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for WorkflowSettings to handle AdditionalProperties
+func (a WorkflowSettings) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.CallerIds != nil {
+		object["callerIds"], err = json.Marshal(a.CallerIds)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'callerIds': %w", err)
+		}
+	}
+
+	if a.CallerPolicy != nil {
+		object["callerPolicy"], err = json.Marshal(a.CallerPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'callerPolicy': %w", err)
+		}
+	}
+
+	if a.ErrorWorkflow != nil {
+		object["errorWorkflow"], err = json.Marshal(a.ErrorWorkflow)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'errorWorkflow': %w", err)
+		}
+	}
+
+	if a.ExecutionOrder != nil {
+		object["executionOrder"], err = json.Marshal(a.ExecutionOrder)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'executionOrder': %w", err)
+		}
+	}
+
+	if a.ExecutionTimeout != nil {
+		object["executionTimeout"], err = json.Marshal(a.ExecutionTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'executionTimeout': %w", err)
+		}
+	}
+
+	if a.SaveDataErrorExecution != nil {
+		object["saveDataErrorExecution"], err = json.Marshal(a.SaveDataErrorExecution)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'saveDataErrorExecution': %w", err)
+		}
+	}
+
+	if a.SaveDataSuccessExecution != nil {
+		object["saveDataSuccessExecution"], err = json.Marshal(a.SaveDataSuccessExecution)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'saveDataSuccessExecution': %w", err)
+		}
+	}
+
+	if a.SaveExecutionProgress != nil {
+		object["saveExecutionProgress"], err = json.Marshal(a.SaveExecutionProgress)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'saveExecutionProgress': %w", err)
+		}
+	}
+
+	if a.SaveManualExecutions != nil {
+		object["saveManualExecutions"], err = json.Marshal(a.SaveManualExecutions)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'saveManualExecutions': %w", err)
+		}
+	}
+
+	if a.TimeSavedPerExecution != nil {
+		object["timeSavedPerExecution"], err = json.Marshal(a.TimeSavedPerExecution)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'timeSavedPerExecution': %w", err)
+		}
+	}
+
+	if a.Timezone != nil {
+		object["timezone"], err = json.Marshal(a.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'timezone': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
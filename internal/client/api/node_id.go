@@ -0,0 +1,34 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NodeID is the id of a workflow node. n8n generates string ids, but
+// workflows written by hand or by other tools may use numbers; both are
+// sent to n8n as they were given.
+type NodeID json.RawMessage
+
+// MarshalJSON implements json.Marshaler
+func (id NodeID) MarshalJSON() ([]byte, error) {
+	if id == nil {
+		return []byte("null"), nil
+	}
+	return id, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (id *NodeID) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch value.(type) {
+	case string, float64:
+		*id = append((*id)[:0], data...)
+		return nil
+	}
+	return fmt.Errorf("node id must be a string or a number, got %s", data)
+}
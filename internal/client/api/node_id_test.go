@@ -0,0 +1,58 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNodeID(t *testing.T) {
+	tests := map[string]struct {
+		node    string
+		want    string
+		wantErr bool
+	}{
+		"string": {
+			node: `{"id":"8b4e7c1a","name":"Start"}`,
+			want: `"8b4e7c1a"`,
+		},
+		"number": {
+			node: `{"id":3,"name":"Start"}`,
+			want: `3`,
+		},
+		"missing": {
+			node: `{"name":"Start"}`,
+		},
+		"object": {
+			node:    `{"id":{},"name":"Start"}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var node Node
+			err := json.Unmarshal([]byte(tt.node), &node)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+
+			var got string
+			if node.Id != nil {
+				data, err := json.Marshal(node.Id)
+				if err != nil {
+					t.Fatalf("marshal: %v", err)
+				}
+				got = string(data)
+			}
+			if got != tt.want {
+				t.Errorf("id = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
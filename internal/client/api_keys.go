@@ -0,0 +1,88 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// APIKey represents a public API key. The full key is only returned when the
+// key is created; afterwards the API returns a redacted value.
+type APIKey struct {
+	ExpiresAt *int64   `json:"expiresAt"`
+	ID        string   `json:"id"`
+	Label     string   `json:"label"`
+	APIKey    string   `json:"apiKey"`
+	CreatedAt string   `json:"createdAt,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+}
+
+// CreateAPIKeyRequest represents the request to create an API key
+type CreateAPIKeyRequest struct {
+	ExpiresAt *int64   `json:"expiresAt"`
+	Label     string   `json:"label"`
+	Scopes    []string `json:"scopes,omitempty"`
+}
+
+// UpdateAPIKeyRequest represents the request to update an API key
+type UpdateAPIKeyRequest struct {
+	Label  string   `json:"label"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// GetAPIKeys retrieves the API keys of the authenticated user
+func (c *Client) GetAPIKeys() ([]APIKey, error) {
+	respBody, err := c.doRequest("GET", "/api/v1/api-keys", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []APIKey
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetAPIKey retrieves an API key of the authenticated user by ID
+func (c *Client) GetAPIKey(id string) (*APIKey, error) {
+	keys, err := c.GetAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range keys {
+		if keys[i].ID == id {
+			return &keys[i], nil
+		}
+	}
+
+	return nil, notFoundError("API key %s not found", id)
+}
+
+// CreateAPIKey creates a new API key and returns it including the full key
+func (c *Client) CreateAPIKey(req *CreateAPIKeyRequest) (*APIKey, error) {
+	respBody, err := c.doRequest("POST", "/api/v1/api-keys", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result APIKey
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateAPIKey updates the label and scopes of an API key
+func (c *Client) UpdateAPIKey(id string, req *UpdateAPIKeyRequest) error {
+	_, err := c.doRequest("PATCH", fmt.Sprintf("/api/v1/api-keys/%s", id), req)
+	return err
+}
+
+// DeleteAPIKey deletes an API key
+func (c *Client) DeleteAPIKey(id string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/api-keys/%s", id), nil)
+	return err
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// AuditSection represents one finding of a risk report
+type AuditSection struct {
+	Title          string        `json:"title"`
+	Description    string        `json:"description"`
+	Recommendation string        `json:"recommendation"`
+	Location       []interface{} `json:"location,omitempty"`
+}
+
+// AuditReport represents the risk report of one audit category
+type AuditReport struct {
+	Risk     string         `json:"risk"`
+	Sections []AuditSection `json:"sections"`
+}
+
+// AuditOptions configures a security audit. Empty fields use n8n's defaults.
+type AuditOptions struct {
+	Categories            []string `json:"categories,omitempty"`
+	DaysAbandonedWorkflow int64    `json:"daysAbandonedWorkflow,omitempty"`
+}
+
+// GenerateAudit runs a security audit and returns the risk reports keyed by
+// report name
+func (c *Client) GenerateAudit(options AuditOptions) (map[string]AuditReport, error) {
+	payload := map[string]interface{}{
+		"additionalOptions": options,
+	}
+
+	respBody, err := c.doRequest("POST", "/api/v1/audit", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// n8n answers with an empty array when no risks were found
+	result := map[string]AuditReport{}
+	if bytes.HasPrefix(bytes.TrimSpace(respBody), []byte("[")) {
+		return result, nil
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
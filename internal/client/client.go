@@ -2,10 +2,12 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -15,496 +17,268 @@ type Client struct {
 	HTTPClient *http.Client
 	BaseURL    string
 	APIKey     string
+
+	// WebhookBaseURL is the base URL n8n serves webhooks under, used to
+	// build webhook URLs. NewClient defaults it to the API base URL.
+	WebhookBaseURL string
+
+	// MaxRetries is how often a request that failed with a transient error
+	// is sent again, and RetryMaxDelay caps the backoff between attempts.
+	// NewClient sets both to their defaults.
+	MaxRetries    int
+	RetryMaxDelay time.Duration
+
+	// UserAgent identifies the provider in the access logs of n8n and of
+	// proxies in front of it
+	UserAgent string
+
+	// BasicAuthUsername and BasicAuthPassword are sent as basic
+	// authentication, e.g. for a reverse proxy in front of n8n.
+	// BearerToken is sent as a bearer token instead; the two are exclusive.
+	BasicAuthUsername string
+	BasicAuthPassword string
+	BearerToken       string
+
+	// ExtraHeaders are sent with every request, e.g. for gateways in front of
+	// n8n that need their own credentials. They cannot replace the headers
+	// the client sets itself.
+	ExtraHeaders map[string]string
+
+	// PageSize is the number of items requested per page from list
+	// endpoints. NewClient defaults it to the maximum of the n8n API.
+	PageSize int
+
+	// ReadCacheTTL is how long a workflow read by a refresh answers further
+	// refreshes of it, e.g. of the activation of the same workflow. Writes
+	// drop it from the cache. Zero disables the cache.
+	ReadCacheTTL time.Duration
+
+	// ExcludePinnedData makes workflow lists, and refreshes of workflows
+	// whose pinned data is not managed, leave out the output pinned to nodes,
+	// which can be large. NewClient enables it.
+	ExcludePinnedData bool
+
+	// WorkflowListRefresh makes RefreshWorkflow serve workflows from a single
+	// cached ListWorkflows response instead of issuing one GET per workflow.
+	WorkflowListRefresh bool
+
+	// limiter spaces out requests when a request rate is set
+	limiter *rateLimiter
+
+	// inFlight holds a slot for each request in flight when the number of
+	// concurrent requests is limited; it is shared by the copies WithContext
+	// makes
+	inFlight chan struct{}
+
+	// workflowList is shared by the copies WithContext makes
+	workflowList *workflowListSnapshot
+
+	// credentialList is shared by the copies WithContext makes
+	credentialList *credentialListSnapshot
+
+	// workflowReads caches the workflows read by refreshes for ReadCacheTTL
+	workflowReads *workflowReadCache
+
+	// workflowFields remembers the optional workflow fields the instance
+	// rejected
+	workflowFields *workflowFields
+
+	// ctx bounds the requests of a client returned by WithContext
+	ctx context.Context
 }
 
+// defaultUserAgent is sent when the provider version is not known
+const defaultUserAgent = "terraform-provider-n8n"
+
+// defaultHTTPTimeout bounds each request of a client that is not bound by
+// the deadline of an operation
+const defaultHTTPTimeout = 30 * time.Second
+
+// defaultReadCacheTTL is long enough for the refreshes of a plan to share
+// reads, and short enough that long applies read workflows again
+const defaultReadCacheTTL = 30 * time.Second
+
+// maxPageSize is the largest page the n8n API returns
+const maxPageSize = 250
+
 // NewClient creates a new n8n API client
 func NewClient(baseURL, apiKey string) *Client {
 	return &Client{
-		BaseURL: strings.TrimSuffix(baseURL, "/"),
-		APIKey:  apiKey,
+		BaseURL:        strings.TrimSuffix(baseURL, "/"),
+		APIKey:         apiKey,
+		WebhookBaseURL: strings.TrimSuffix(baseURL, "/"),
+		UserAgent:      defaultUserAgent,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: defaultHTTPTimeout,
 		},
+		MaxRetries:        defaultMaxRetries,
+		RetryMaxDelay:     defaultRetryMaxDelay,
+		PageSize:          maxPageSize,
+		ReadCacheTTL:      defaultReadCacheTTL,
+		ExcludePinnedData: true,
+		workflowList:      &workflowListSnapshot{},
+		credentialList:    &credentialListSnapshot{},
+		workflowReads:     &workflowReadCache{},
+		workflowFields:    &workflowFields{},
 	}
 }
 
-// doRequest performs an HTTP request with authentication
-func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
-		}
-		reqBody = bytes.NewBuffer(jsonBody)
-	}
-
-	url := fmt.Sprintf("%s%s", c.BaseURL, path)
-	req, err := http.NewRequest(method, url, reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// pageSize returns the page size to request from list endpoints
+func (c *Client) pageSize() string {
+	if c.PageSize <= 0 || c.PageSize > maxPageSize {
+		return strconv.Itoa(maxPageSize)
 	}
+	return strconv.Itoa(c.PageSize)
+}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("X-N8N-API-KEY", c.APIKey)
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+// WithContext returns a copy of the client whose requests are canceled with
+// ctx. When ctx has a deadline, it replaces the timeout of each request, so
+// that operations on slow instances can take longer than the default. A nil
+// client, as seen before the provider is configured, stays nil.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if c == nil {
+		return nil
 	}
-	defer func() {
-		if closeErr := resp.Body.Close(); closeErr != nil {
-			// Log the error but don't override the main error
-			_ = closeErr
-		}
-	}()
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
+	copied := *c
+	copied.ctx = ctx
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+	if _, ok := ctx.Deadline(); ok {
+		httpClient := *c.HTTPClient
+		httpClient.Timeout = 0
+		copied.HTTPClient = &httpClient
 	}
 
-	return respBody, nil
+	return &copied
 }
 
-// Workflow represents an n8n workflow
-type Workflow struct {
-	Connections map[string]interface{} `json:"connections"`
-	Settings    map[string]interface{} `json:"settings,omitempty"`
-	ID          string                 `json:"id,omitempty"`
-	Name        string                 `json:"name"`
-	CreatedAt   string                 `json:"createdAt,omitempty"`
-	UpdatedAt   string                 `json:"updatedAt,omitempty"`
-	Nodes       []interface{}          `json:"nodes"`
-	Tags        []map[string]string    `json:"tags,omitempty"`
-	Active      bool                   `json:"active"`
+// APIEndpoint returns the base URL of the n8n API
+func (c *Client) APIEndpoint() string {
+	return c.BaseURL
 }
 
-// WorkflowListResponse represents the response from listing workflows
-type WorkflowListResponse struct {
-	Data []Workflow `json:"data"`
+// WebhookEndpoint returns the base URL n8n serves webhooks under
+func (c *Client) WebhookEndpoint() string {
+	return c.WebhookBaseURL
 }
 
-// CreateWorkflow creates a new workflow
-func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
-	// Store the desired tags (read-only on creation)
-	// Note: active field is now managed by n8n_workflow_activation resource
-	desiredTags := workflow.Tags
-
-	// Create workflow without tags field (it's read-only on creation)
-	createPayload := map[string]interface{}{
-		"name":        workflow.Name,
-		"nodes":       workflow.Nodes,
-		"connections": workflow.Connections,
-	}
-
-	if workflow.Settings != nil {
-		createPayload["settings"] = workflow.Settings
-	}
-
-	respBody, err := c.doRequest("POST", "/api/v1/workflows", createPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	var result Workflow
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	// If tags are specified, update them after creation
-	// Only update if tags have actual content (not just empty array from n8n export)
-	if len(desiredTags) > 0 {
-		// Check if tags have valid IDs
-		hasValidTags := false
-		for _, tag := range desiredTags {
-			if id, ok := tag["id"]; ok && id != "" {
-				hasValidTags = true
-				break
-			}
-		}
-
-		if hasValidTags {
-			if err := c.UpdateWorkflowTags(result.ID, desiredTags); err != nil {
-				// If tags update fails, delete the workflow to clean up
-				deleteErr := c.DeleteWorkflow(result.ID)
-				if deleteErr != nil {
-					return nil, fmt.Errorf("failed to update workflow tags: %w (also failed to clean up workflow: %v) - hint: tags must exist in n8n before assigning them to workflows", err, deleteErr)
-				}
-				return nil, fmt.Errorf("failed to update workflow tags, workflow rolled back: %w (hint: tags must exist in n8n before assigning them to workflows)", err)
-			}
-			result.Tags = desiredTags
-		}
+// context returns the context requests are made with
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
 	}
-
-	return &result, nil
+	return c.ctx
 }
 
-// GetWorkflow retrieves a workflow by ID
-func (c *Client) GetWorkflow(id string) (*Workflow, error) {
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/workflows/%s", id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var result Workflow
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	return &result, nil
-}
-
-// UpdateWorkflow updates an existing workflow
-func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error) {
-	// Store the desired tags (read-only)
-	// Note: active field is now managed by n8n_workflow_activation resource
-	desiredTags := workflow.Tags
-
-	// Update workflow without tags field (it's read-only)
-	updatePayload := map[string]interface{}{
-		"name":        workflow.Name,
-		"nodes":       workflow.Nodes,
-		"connections": workflow.Connections,
-	}
-
-	if workflow.Settings != nil {
-		updatePayload["settings"] = workflow.Settings
-	}
-
-	respBody, err := c.doRequest("PUT", fmt.Sprintf("/api/v1/workflows/%s", id), updatePayload)
-	if err != nil {
-		return nil, err
+// doRequest performs an HTTP request with authentication
+func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error) {
+	// The API key may be omitted while bootstrapping a new instance with
+	// n8n_owner_setup, or when a bearer token or basic authentication
+	// authenticates the requests; every other request needs it
+	if c.APIKey == "" && c.BearerToken == "" && c.BasicAuthUsername == "" {
+		return nil, fmt.Errorf("no n8n API key configured: set api_key in the provider configuration or the N8N_API_KEY environment variable")
 	}
 
-	var result Workflow
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	var jsonBody []byte
+	if body != nil {
+		var err error
+		jsonBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
 	}
 
-	// Update tags if they changed
-	if len(desiredTags) > 0 {
-		// Check if tags have valid IDs
-		hasValidTags := false
-		for _, tag := range desiredTags {
-			if id, ok := tag["id"]; ok && id != "" {
-				hasValidTags = true
-				break
-			}
+	// Send the request again after transient errors, as long as that is safe
+	for retry := 0; ; retry++ {
+		respBody, status, header, err := c.sendRequest(method, path, jsonBody)
+		if c.retryable(method, status, err) && retry < c.MaxRetries && c.waitForRetry(retry+1, retryAfter(header)) {
+			continue
 		}
 
-		if hasValidTags {
-			if err := c.UpdateWorkflowTags(id, desiredTags); err != nil {
-				return nil, fmt.Errorf("failed to update workflow tags: %w (hint: tags must exist in n8n before assigning them to workflows)", err)
-			}
-			result.Tags = desiredTags
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	return &result, nil
-}
-
-// DeleteWorkflow deletes a workflow
-func (c *Client) DeleteWorkflow(id string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/workflows/%s", id), nil)
-	return err
-}
-
-// ActivateWorkflow activates a workflow
-func (c *Client) ActivateWorkflow(id string) (*Workflow, error) {
-	respBody, err := c.doRequest("POST", fmt.Sprintf("/api/v1/workflows/%s/activate", id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var result Workflow
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	return &result, nil
-}
-
-// DeactivateWorkflow deactivates a workflow
-func (c *Client) DeactivateWorkflow(id string) (*Workflow, error) {
-	respBody, err := c.doRequest("POST", fmt.Sprintf("/api/v1/workflows/%s/deactivate", id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var result Workflow
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	return &result, nil
-}
-
-// UpdateWorkflowTags updates the tags of a workflow
-func (c *Client) UpdateWorkflowTags(id string, tags []map[string]string) error {
-	// Convert tags to the format expected by the API
-	tagPayload := make([]map[string]string, len(tags))
-	for i, tag := range tags {
-		tagPayload[i] = map[string]string{
-			"id": tag["id"],
+		if status < 200 || status >= 300 {
+			return nil, newAPIError(status, respBody)
 		}
+		return respBody, nil
 	}
-
-	_, err := c.doRequest("PUT", fmt.Sprintf("/api/v1/workflows/%s/tags", id), tagPayload)
-	return err
 }
 
-// ListWorkflows lists all workflows
-func (c *Client) ListWorkflows() ([]Workflow, error) {
-	respBody, err := c.doRequest("GET", "/api/v1/workflows", nil)
-	if err != nil {
-		return nil, err
+// sendRequest sends a single authenticated request and returns the response
+// body, status and headers
+func (c *Client) sendRequest(method, path string, jsonBody []byte) ([]byte, int, http.Header, error) {
+	if err := c.limiter.wait(c.context()); err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to execute request: %w", err)
 	}
-
-	var result WorkflowListResponse
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	return result.Data, nil
-}
-
-// Credential represents an n8n credential
-type Credential struct {
-	Data map[string]interface{} `json:"data,omitempty"`
-	ID   string                 `json:"id,omitempty"`
-	Name string                 `json:"name"`
-	Type string                 `json:"type"`
-}
-
-// CredentialListResponse represents the response from listing credentials
-type CredentialListResponse struct {
-	Data []Credential `json:"data"`
-}
-
-// CreateCredential creates a new credential
-func (c *Client) CreateCredential(credential *Credential) (*Credential, error) {
-	respBody, err := c.doRequest("POST", "/api/v1/credentials", credential)
-	if err != nil {
-		return nil, err
-	}
-
-	var result Credential
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	return &result, nil
-}
-
-// GetCredential retrieves a credential by ID
-func (c *Client) GetCredential(id string) (*Credential, error) {
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/credentials/%s", id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var result Credential
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	return &result, nil
-}
-
-// DeleteCredential deletes a credential
-func (c *Client) DeleteCredential(id string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/credentials/%s", id), nil)
-	return err
-}
-
-// ListCredentials lists all credentials
-func (c *Client) ListCredentials() ([]Credential, error) {
-	respBody, err := c.doRequest("GET", "/api/v1/credentials", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var result CredentialListResponse
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	return result.Data, nil
-}
-
-// User represents an n8n user
-type User struct {
-	ID              string `json:"id,omitempty"`
-	Email           string `json:"email"`
-	Role            string `json:"role,omitempty"`
-	GlobalRole      string `json:"globalRole,omitempty"` // Some n8n versions use globalRole instead of role
-	CreatedAt       string `json:"createdAt,omitempty"`
-	UpdatedAt       string `json:"updatedAt,omitempty"`
-	InviteAcceptURL string `json:"inviteAcceptUrl,omitempty"` // Only populated on user creation
-	IsOwner         bool   `json:"isOwner,omitempty"`
-	IsPending       bool   `json:"isPending,omitempty"`
-}
-
-// GetRole returns the role, preferring GlobalRole if Role is empty
-func (u *User) GetRole() string {
-	if u.Role != "" {
-		return u.Role
-	}
-	return u.GlobalRole
-}
-
-// SetRole sets both Role and GlobalRole to ensure compatibility
-func (u *User) SetRole(role string) {
-	u.Role = role
-	u.GlobalRole = role
-}
-
-// UserListResponse represents the response from listing users
-type UserListResponse struct {
-	Data []User `json:"data"`
-}
-
-// CreateUserResponse represents the response from creating users
-type CreateUserResponse struct {
-	Error string `json:"error"`
-	User  struct {
-		ID              string `json:"id"`
-		Email           string `json:"email"`
-		InviteAcceptURL string `json:"inviteAcceptUrl,omitempty"`
-		Role            string `json:"role,omitempty"`
-		EmailSent       bool   `json:"emailSent,omitempty"`
-	} `json:"user"`
-}
-
-// CreateUser creates a new user
-func (c *Client) CreateUser(user *User) (*User, error) {
-	// n8n API expects an array of users for bulk creation
-	// The request should only include email and role
-	type CreateUserRequest struct {
-		Email string `json:"email"`
-		Role  string `json:"role,omitempty"`
-	}
-
-	request := CreateUserRequest{
-		Email: user.Email,
-		Role:  user.Role,
-	}
-
-	users := []CreateUserRequest{request}
-	respBody, err := c.doRequest("POST", "/api/v1/users", users)
+	release, err := c.acquireSlot()
 	if err != nil {
-		return nil, err
-	}
-
-	// The response is an array of objects with "user" and "error" fields
-	var results []CreateUserResponse
-	if err := json.Unmarshal(respBody, &results); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		return nil, 0, nil, fmt.Errorf("failed to execute request: %w", err)
 	}
+	defer release()
 
-	if len(results) == 0 {
-		return nil, fmt.Errorf("no user returned from API")
-	}
-
-	if results[0].Error != "" {
-		return nil, fmt.Errorf("API error: %s", results[0].Error)
+	var reqBody io.Reader
+	if jsonBody != nil {
+		reqBody = bytes.NewReader(jsonBody)
 	}
 
-	// Preserve the inviteAcceptUrl from the creation response
-	inviteAcceptURL := results[0].User.InviteAcceptURL
-
-	// Fetch the full user details to get all fields including role, timestamps, etc.
-	// The create response doesn't include all fields we need
-	createdUser, err := c.GetUser(results[0].User.ID)
+	url := fmt.Sprintf("%s%s", c.BaseURL, path)
+	req, err := http.NewRequestWithContext(c.context(), method, url, reqBody)
 	if err != nil {
-		return nil, err
+		return nil, 0, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// If the API doesn't return the role in GetUser response, preserve the role from the request
-	// This handles cases where n8n API doesn't return role/globalRole in the GET response
-	if createdUser.GetRole() == "" && user.Role != "" {
-		createdUser.SetRole(user.Role)
+	// Set headers
+	requestID := newRequestID()
+	c.setExtraHeaders(req)
+	c.setAuthHeaders(req)
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if c.APIKey != "" {
+		req.Header.Set("X-N8N-API-KEY", c.APIKey)
 	}
+	req.Header.Set("X-Request-Id", requestID)
 
-	// Set the inviteAcceptUrl from the creation response (not available in GET response)
-	createdUser.InviteAcceptURL = inviteAcceptURL
-
-	return createdUser, nil
-}
-
-// GetUser retrieves a user by ID
-func (c *Client) GetUser(id string) (*User, error) {
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/users/%s", id), nil)
+	start := time.Now()
+	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
-	}
-
-	var result User
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		err = fmt.Errorf("failed to execute request: %w", err)
+		c.logRequest(method, path, requestID, 0, time.Since(start), jsonBody, nil, err)
+		return nil, 0, nil, err
 	}
-
-	return &result, nil
-}
-
-// UpdateUser updates an existing user's role
-// Note: According to n8n API docs, only the role can be updated via PATCH /users/{id}/role
-func (c *Client) UpdateUser(id string, user *User) (*User, error) {
-	// Update the role if it's provided
-	if user.Role != "" {
-		type UpdateRoleRequest struct {
-			NewRoleName string `json:"newRoleName"`
-		}
-
-		request := UpdateRoleRequest{
-			NewRoleName: user.Role,
-		}
-
-		_, err := c.doRequest("PATCH", fmt.Sprintf("/api/v1/users/%s/role", id), request)
-		if err != nil {
-			return nil, err
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			// Log the error but don't override the main error
+			_ = closeErr
 		}
-	}
+	}()
 
-	// After updating, fetch the user to get the current state
-	updatedUser, err := c.GetUser(id)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
-	}
-
-	// If the API doesn't return the role in GetUser response, preserve the role from the request
-	// This handles cases where n8n API doesn't return role/globalRole in the GET response
-	if updatedUser.GetRole() == "" && user.Role != "" {
-		updatedUser.SetRole(user.Role)
+		err = fmt.Errorf("failed to read response body: %w", err)
+		c.logRequest(method, path, requestID, resp.StatusCode, time.Since(start), jsonBody, nil, err)
+		return nil, 0, nil, err
 	}
+	c.logRequest(method, path, requestID, resp.StatusCode, time.Since(start), jsonBody, respBody, nil)
 
-	return updatedUser, nil
+	return respBody, resp.StatusCode, resp.Header, nil
 }
 
-// DeleteUser deletes a user
-func (c *Client) DeleteUser(id string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/users/%s", id), nil)
-	return err
-}
-
-// ListUsers lists all users
-func (c *Client) ListUsers() ([]User, error) {
-	respBody, err := c.doRequest("GET", "/api/v1/users", nil)
-	if err != nil {
-		return nil, err
+// setExtraHeaders adds the configured extra headers to a request
+func (c *Client) setExtraHeaders(req *http.Request) {
+	for name, value := range c.ExtraHeaders {
+		req.Header.Set(name, value)
 	}
+}
 
-	var result UserListResponse
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+// setAuthHeaders adds the configured basic authentication or bearer token
+// to a request
+func (c *Client) setAuthHeaders(req *http.Request) {
+	switch {
+	case c.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+c.BearerToken)
+	case c.BasicAuthUsername != "":
+		req.SetBasicAuth(c.BasicAuthUsername, c.BasicAuthPassword)
 	}
-
-	return result.Data, nil
 }
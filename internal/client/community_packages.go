@@ -0,0 +1,107 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// CommunityPackageNode represents a node type provided by a community package
+type CommunityPackageNode struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	LatestVersion int64  `json:"latestVersion"`
+}
+
+// CommunityPackage represents an installed community node package
+type CommunityPackage struct {
+	PackageName      string                 `json:"packageName"`
+	InstalledVersion string                 `json:"installedVersion"`
+	AuthorName       string                 `json:"authorName,omitempty"`
+	AuthorEmail      string                 `json:"authorEmail,omitempty"`
+	UpdateAvailable  string                 `json:"updateAvailable,omitempty"`
+	InstalledNodes   []CommunityPackageNode `json:"installedNodes"`
+}
+
+// GetCommunityPackages retrieves all installed community packages
+func (c *Client) GetCommunityPackages() ([]CommunityPackage, error) {
+	respBody, err := c.doRequest("GET", "/api/v1/community-packages", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []CommunityPackage
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetCommunityPackage retrieves an installed community package by name
+func (c *Client) GetCommunityPackage(name string) (*CommunityPackage, error) {
+	packages, err := c.GetCommunityPackages()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range packages {
+		if packages[i].PackageName == name {
+			return &packages[i], nil
+		}
+	}
+
+	return nil, notFoundError("community package %s is not installed", name)
+}
+
+// InstallCommunityPackage installs a community package. An empty version
+// installs the latest release.
+func (c *Client) InstallCommunityPackage(name, version string) (*CommunityPackage, error) {
+	payload := map[string]string{
+		"name": name,
+	}
+	if version != "" {
+		payload["version"] = version
+	}
+
+	respBody, err := c.doRequest("POST", "/api/v1/community-packages", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CommunityPackage
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateCommunityPackage moves an installed community package to another
+// version. An empty version updates to the latest release.
+func (c *Client) UpdateCommunityPackage(name, version string) (*CommunityPackage, error) {
+	payload := map[string]string{
+		"name": name,
+	}
+	if version != "" {
+		payload["version"] = version
+	}
+
+	respBody, err := c.doRequest("PATCH", "/api/v1/community-packages", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CommunityPackage
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UninstallCommunityPackage uninstalls a community package
+func (c *Client) UninstallCommunityPackage(name string) error {
+	_, err := c.doRequest("DELETE", "/api/v1/community-packages?name="+url.QueryEscape(name), nil)
+	return err
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"fmt"
+)
+
+// ShareCredential replaces the set of projects a credential is shared with.
+// Users are shared with through their personal project.
+func (c *Client) ShareCredential(id string, projectIDs []string) error {
+	payload := map[string][]string{
+		"shareWithIds": projectIDs,
+	}
+
+	_, err := c.doRequest("PUT", fmt.Sprintf("/api/v1/credentials/%s/share", id), payload)
+	c.forgetCredentialList()
+	return err
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+)
+
+// Credential represents an n8n credential
+type Credential struct {
+	Data map[string]interface{} `json:"data,omitempty"`
+	ID   string                 `json:"id,omitempty"`
+	Name string                 `json:"name"`
+	Type string                 `json:"type"`
+	// ProjectID is the project a new credential is created in; empty for the
+	// personal project of the API key owner
+	ProjectID string           `json:"projectId,omitempty"`
+	Shared    []SharedResource `json:"shared,omitempty"`
+	Scopes    []string         `json:"scopes,omitempty"`
+	CreatedAt string           `json:"createdAt,omitempty"`
+	UpdatedAt string           `json:"updatedAt,omitempty"`
+}
+
+// OwnerProjectID returns the ID of the project that owns the credential, or
+// an empty string if the API did not include sharing details
+func (c *Credential) OwnerProjectID() string {
+	for _, shared := range c.Shared {
+		if shared.Role == "credential:owner" {
+			return shared.ProjectID
+		}
+	}
+	return ""
+}
+
+// credentialListSnapshot is the credential list RefreshCredential answers
+// from
+type credentialListSnapshot struct {
+	mu    sync.Mutex
+	cache map[string]*Credential
+}
+
+// CredentialListResponse represents the response from listing credentials
+type CredentialListResponse struct {
+	Data       []Credential `json:"data"`
+	NextCursor string       `json:"nextCursor"`
+}
+
+// CreateCredential creates a new credential
+func (c *Client) CreateCredential(credential *Credential) (*Credential, error) {
+	respBody, err := c.doRequest("POST", "/api/v1/credentials", credential)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Credential
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// The snapshot does not list the new credential
+	c.forgetCredentialList()
+
+	return &result, nil
+}
+
+// GetCredential retrieves a credential by ID
+func (c *Client) GetCredential(id string) (*Credential, error) {
+	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/credentials/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Credential
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteCredential deletes a credential
+func (c *Client) DeleteCredential(id string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/credentials/%s", id), nil)
+	c.forgetCredentialList()
+	return err
+}
+
+// TransferCredential moves a credential to another project
+func (c *Client) TransferCredential(id, projectID string) error {
+	payload := map[string]string{
+		"destinationProjectId": projectID,
+	}
+
+	_, err := c.doRequest("PUT", fmt.Sprintf("/api/v1/credentials/%s/transfer", id), payload)
+	c.forgetCredentialList()
+	return err
+}
+
+// CredentialTestResult is the outcome of testing a credential against the
+// service it authenticates with
+type CredentialTestResult struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// OK reports whether the credential authenticated
+func (r *CredentialTestResult) OK() bool {
+	return r.Status == "OK"
+}
+
+// TestCredential tests a credential the way the editor does. The endpoint is
+// part of the internal REST API, which not every instance accepts API keys for.
+func (c *Client) TestCredential(credential *Credential) (*CredentialTestResult, error) {
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":   credential.ID,
+			"name": credential.Name,
+			"type": credential.Type,
+			"data": credential.Data,
+		},
+	}
+
+	respBody, err := c.doRequest("POST", "/rest/credentials/test", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data CredentialTestResult `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result.Data, nil
+}
+
+// ListCredentials lists all credentials
+func (c *Client) ListCredentials() ([]Credential, error) {
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+
+	var credentials []Credential
+	for {
+		respBody, err := c.doRequest("GET", "/api/v1/credentials?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result CredentialListResponse
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		credentials = append(credentials, result.Data...)
+		if result.NextCursor == "" {
+			return credentials, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
+
+// RefreshCredential retrieves a credential for a state refresh, or nil if it
+// is not listed. The n8n API cannot read a single credential, so the
+// credentials are listed once and the refreshes of all credentials are
+// answered from that snapshot until a credential is changed.
+func (c *Client) RefreshCredential(id string) (*Credential, error) {
+	c.credentialList.mu.Lock()
+	defer c.credentialList.mu.Unlock()
+
+	if c.credentialList.cache == nil {
+		credentials, err := c.ListCredentials()
+		if err != nil {
+			return nil, err
+		}
+
+		c.credentialList.cache = make(map[string]*Credential, len(credentials))
+		for i := range credentials {
+			c.credentialList.cache[credentials[i].ID] = &credentials[i]
+		}
+	}
+
+	credential, ok := c.credentialList.cache[id]
+	if !ok {
+		return nil, nil
+	}
+
+	// The snapshot is shared by all resources refreshed from it
+	copied := *credential
+	copied.Shared = slices.Clone(credential.Shared)
+	copied.Scopes = slices.Clone(credential.Scopes)
+	return &copied, nil
+}
+
+// forgetCredentialList drops the credential list snapshot after a change,
+// so that the next refresh lists the credentials again
+func (c *Client) forgetCredentialList() {
+	c.credentialList.mu.Lock()
+	c.credentialList.cache = nil
+	c.credentialList.mu.Unlock()
+}
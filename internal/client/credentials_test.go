@@ -0,0 +1,67 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRefreshCredential(t *testing.T) {
+	var lists atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := `{"id":"2","name":"Slack","type":"slackApi"}`
+		if r.Method == http.MethodGet {
+			lists.Add(1)
+			body = `{"data":[{"id":"1","name":"Mail","type":"smtp","scopes":["credential:read"],"shared":[]}]}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key")
+
+	first, err := c.RefreshCredential("1")
+	if err != nil {
+		t.Fatalf("first refresh: %v", err)
+	}
+	if first == nil || first.Name != "Mail" {
+		t.Fatalf("first refresh = %+v, want credential Mail", first)
+	}
+	if first.Shared == nil {
+		t.Error("first refresh lost the empty sharing list")
+	}
+	first.Scopes[0] = "changed"
+
+	missing, err := c.RefreshCredential("3")
+	if err != nil {
+		t.Fatalf("refreshing a missing credential: %v", err)
+	}
+	if missing != nil {
+		t.Errorf("refreshing a missing credential = %+v, want nil", missing)
+	}
+
+	second, err := c.WithContext(t.Context()).RefreshCredential("1")
+	if err != nil {
+		t.Fatalf("second refresh: %v", err)
+	}
+	if lists.Load() != 1 {
+		t.Errorf("got %d list requests, want the refreshes to share one", lists.Load())
+	}
+	if second.Scopes[0] != "credential:read" {
+		t.Errorf("second refresh returned scope %q, want the snapshot to be unchanged", second.Scopes[0])
+	}
+
+	if _, err := c.CreateCredential(&Credential{Name: "Slack", Type: "slackApi"}); err != nil {
+		t.Fatalf("creating a credential: %v", err)
+	}
+	if _, err := c.RefreshCredential("1"); err != nil {
+		t.Fatalf("refresh after creating a credential: %v", err)
+	}
+	if lists.Load() != 2 {
+		t.Errorf("got %d list requests, want creating a credential to drop the snapshot", lists.Load())
+	}
+}
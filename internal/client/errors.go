@@ -0,0 +1,251 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// APIError is the error returned for a response with an error status
+type APIError struct {
+	StatusCode int
+	// Message, Description and Hint are taken from the n8n error body, when
+	// there is one
+	Message     string
+	Description string
+	Hint        string
+	// Node is the name of the workflow node the error is about, if any
+	Node string
+	// Issues are the validation errors of a rejected request body
+	Issues []ValidationIssue
+	// Body is the raw response body
+	Body string
+}
+
+// ValidationIssue is a problem with one field of a request body
+type ValidationIssue struct {
+	// Path is the field, e.g. nodes[2].parameters.url
+	Path    string
+	Message string
+}
+
+// newAPIError builds the error for a response with an error status
+func newAPIError(status int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: status, Body: string(body)}
+
+	var parsed struct {
+		Message     string            `json:"message"`
+		Description string            `json:"description"`
+		Hint        string            `json:"hint"`
+		Node        json.RawMessage   `json:"node"`
+		Errors      []json.RawMessage `json:"errors"`
+		Issues      []json.RawMessage `json:"issues"`
+	}
+	if json.Unmarshal(body, &parsed) != nil {
+		return apiErr
+	}
+
+	apiErr.Message = parsed.Message
+	apiErr.Description = parsed.Description
+	apiErr.Hint = parsed.Hint
+	apiErr.Node = nodeName(parsed.Node)
+	for _, raw := range append(parsed.Errors, parsed.Issues...) {
+		if issue, ok := validationIssue(raw); ok {
+			apiErr.Issues = append(apiErr.Issues, issue)
+		}
+	}
+
+	return apiErr
+}
+
+// nodeName reads the node of an n8n error, given by name or as the node
+// object itself
+func nodeName(raw json.RawMessage) string {
+	var name string
+	if json.Unmarshal(raw, &name) == nil {
+		return name
+	}
+
+	var node struct {
+		Name string `json:"name"`
+	}
+	if json.Unmarshal(raw, &node) == nil {
+		return node.Name
+	}
+	return ""
+}
+
+// validationIssue reads a validation error, either of the request validator
+// of the public API, whose path is a string like /body/nodes/0/name, or of
+// n8n itself, whose path is a list of keys and indexes
+func validationIssue(raw json.RawMessage) (ValidationIssue, bool) {
+	var parsed struct {
+		Path    json.RawMessage `json:"path"`
+		Message string          `json:"message"`
+	}
+	if json.Unmarshal(raw, &parsed) != nil || parsed.Message == "" {
+		return ValidationIssue{}, false
+	}
+
+	var segments []string
+	var pathString string
+	var pathList []interface{}
+	switch {
+	case json.Unmarshal(parsed.Path, &pathString) == nil:
+		segments = strings.FieldsFunc(pathString, func(r rune) bool { return r == '/' || r == '.' })
+	case json.Unmarshal(parsed.Path, &pathList) == nil:
+		for _, segment := range pathList {
+			segments = append(segments, fmt.Sprint(segment))
+		}
+	}
+	if len(segments) > 0 && segments[0] == "body" {
+		segments = segments[1:]
+	}
+
+	return ValidationIssue{Path: fieldPath(segments), Message: parsed.Message}, true
+}
+
+// fieldPath joins path segments the way Terraform shows attribute paths,
+// e.g. nodes[2].parameters
+func fieldPath(segments []string) string {
+	var b strings.Builder
+	for _, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil {
+			b.WriteString("[" + segment + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(".")
+		}
+		b.WriteString(segment)
+	}
+	return b.String()
+}
+
+// notFoundError builds the error for an object that a list did not contain,
+// so that it is handled like a 404 of the API
+func notFoundError(format string, args ...interface{}) *APIError {
+	return &APIError{StatusCode: http.StatusNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Detail())
+}
+
+// Detail returns the human-readable explanation of the error: the n8n
+// message with its description, node, validation issues and hint, or the raw
+// body if n8n sent no message
+func (e *APIError) Detail() string {
+	if e.Message == "" && len(e.Issues) == 0 {
+		if body := strings.TrimSpace(e.Body); body != "" {
+			return body
+		}
+		return http.StatusText(e.StatusCode)
+	}
+
+	detail := e.Message
+	if e.Description != "" && e.Description != e.Message {
+		detail += ": " + e.Description
+	}
+	if e.Node != "" {
+		detail += fmt.Sprintf(" (node %q)", e.Node)
+	}
+	if detail == "" {
+		// Head the validation issues with the status instead of a message
+		detail = http.StatusText(e.StatusCode)
+	}
+	for _, issue := range e.Issues {
+		if issue.Path != "" {
+			detail += "\n  - " + issue.Path + ": " + issue.Message
+		} else {
+			detail += "\n  - " + issue.Message
+		}
+	}
+	if e.Hint != "" {
+		detail += "\n" + e.Hint
+	}
+	return detail
+}
+
+// nodeIndexPath matches a field path into the nodes of a workflow
+var nodeIndexPath = regexp.MustCompile(`^nodes\[(\d+)\]`)
+
+// workflowError names the node that a rejected workflow request is about,
+// when n8n only gave the index of the node in the path of a validation issue
+func workflowError(err error, nodes []interface{}) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Node != "" {
+		return err
+	}
+
+	for _, issue := range apiErr.Issues {
+		match := nodeIndexPath.FindStringSubmatch(issue.Path)
+		if match == nil {
+			continue
+		}
+		index, _ := strconv.Atoi(match[1])
+		if index >= len(nodes) {
+			continue
+		}
+		if node, ok := nodes[index].(map[string]interface{}); ok {
+			if name, ok := node["name"].(string); ok && name != "" {
+				apiErr.Node = name
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// ErrorNode returns the name of the workflow node that err is about, or an
+// empty string if err does not name one
+func ErrorNode(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Node
+	}
+	return ""
+}
+
+// statusCode returns the status of the API error in err, or 0 if err is not one
+func statusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an API error for a missing object
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is an API error for a missing or
+// invalid API key
+func IsUnauthorized(err error) bool {
+	return statusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is an API error for a request the API key
+// is not allowed to make
+func IsForbidden(err error) bool {
+	return statusCode(err) == http.StatusForbidden
+}
+
+// IsLicenseRequired reports whether err is an API error for a feature the
+// license of the instance does not include
+func IsLicenseRequired(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		return false
+	}
+
+	message := strings.ToLower(apiErr.Message + " " + apiErr.Hint)
+	return strings.Contains(message, "license") || strings.Contains(message, "feat:")
+}
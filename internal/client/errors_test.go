@@ -0,0 +1,267 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		body   string
+		want   APIError
+	}{
+		"message and hint": {
+			status: http.StatusBadRequest,
+			body:   `{"message":"request/body must have required property 'name'","hint":"Add a name"}`,
+			want: APIError{
+				StatusCode: http.StatusBadRequest,
+				Message:    "request/body must have required property 'name'",
+				Hint:       "Add a name",
+			},
+		},
+		"not json": {
+			status: http.StatusBadGateway,
+			body:   "<html>Bad Gateway</html>",
+			want:   APIError{StatusCode: http.StatusBadGateway},
+		},
+		"empty body": {
+			status: http.StatusNotFound,
+			want:   APIError{StatusCode: http.StatusNotFound},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := newAPIError(tt.status, []byte(tt.body))
+			if got.StatusCode != tt.want.StatusCode || got.Message != tt.want.Message || got.Hint != tt.want.Hint {
+				t.Errorf("newAPIError() = %+v, want %+v", got, tt.want)
+			}
+			if got.Body != tt.body {
+				t.Errorf("newAPIError() body = %q, want %q", got.Body, tt.body)
+			}
+		})
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	license := newAPIError(http.StatusForbidden, []byte(`{"message":"Your license does not allow for feat:sourceControl"}`))
+	forbidden := newAPIError(http.StatusForbidden, []byte(`{"message":"Forbidden"}`))
+
+	tests := map[string]struct {
+		err             error
+		notFound        bool
+		unauthorized    bool
+		forbidden       bool
+		licenseRequired bool
+	}{
+		"not found": {
+			err:      newAPIError(http.StatusNotFound, nil),
+			notFound: true,
+		},
+		"wrapped not found": {
+			err:      fmt.Errorf("reading workflow: %w", newAPIError(http.StatusNotFound, nil)),
+			notFound: true,
+		},
+		"not found from a list": {
+			err:      notFoundError("no credential with ID %s", "c1"),
+			notFound: true,
+		},
+		"unauthorized": {
+			err:          newAPIError(http.StatusUnauthorized, nil),
+			unauthorized: true,
+		},
+		"forbidden": {
+			err:       forbidden,
+			forbidden: true,
+		},
+		"license required": {
+			err:             license,
+			forbidden:       true,
+			licenseRequired: true,
+		},
+		"other error": {
+			err: errors.New("connection refused"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.notFound {
+				t.Errorf("IsNotFound() = %t, want %t", got, tt.notFound)
+			}
+			if got := IsUnauthorized(tt.err); got != tt.unauthorized {
+				t.Errorf("IsUnauthorized() = %t, want %t", got, tt.unauthorized)
+			}
+			if got := IsForbidden(tt.err); got != tt.forbidden {
+				t.Errorf("IsForbidden() = %t, want %t", got, tt.forbidden)
+			}
+			if got := IsLicenseRequired(tt.err); got != tt.licenseRequired {
+				t.Errorf("IsLicenseRequired() = %t, want %t", got, tt.licenseRequired)
+			}
+		})
+	}
+}
+
+func TestValidationIssue(t *testing.T) {
+	tests := map[string]struct {
+		raw    string
+		want   ValidationIssue
+		wantOK bool
+	}{
+		"request validator path": {
+			raw:    `{"path":"/body/nodes/2/parameters/url","message":"must be string"}`,
+			want:   ValidationIssue{Path: "nodes[2].parameters.url", Message: "must be string"},
+			wantOK: true,
+		},
+		"dotted path": {
+			raw:    `{"path":".body.settings.timezone","message":"must be string"}`,
+			want:   ValidationIssue{Path: "settings.timezone", Message: "must be string"},
+			wantOK: true,
+		},
+		"path list": {
+			raw:    `{"path":["nodes",0,"name"],"message":"Required"}`,
+			want:   ValidationIssue{Path: "nodes[0].name", Message: "Required"},
+			wantOK: true,
+		},
+		"no path": {
+			raw:    `{"message":"request/body must NOT have additional properties"}`,
+			want:   ValidationIssue{Message: "request/body must NOT have additional properties"},
+			wantOK: true,
+		},
+		"no message": {
+			raw: `{"path":"/body/name"}`,
+		},
+		"not an object": {
+			raw: `"must be string"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := validationIssue([]byte(tt.raw))
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("validationIssue() = %+v, %t, want %+v, %t", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestFieldPath(t *testing.T) {
+	tests := map[string]struct {
+		segments []string
+		want     string
+	}{
+		"empty":          {},
+		"field":          {segments: []string{"name"}, want: "name"},
+		"nested":         {segments: []string{"settings", "timezone"}, want: "settings.timezone"},
+		"index":          {segments: []string{"nodes", "2", "parameters"}, want: "nodes[2].parameters"},
+		"nested indexes": {segments: []string{"connections", "Start", "main", "0", "1"}, want: "connections.Start.main[0][1]"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := fieldPath(tt.segments); got != tt.want {
+				t.Errorf("fieldPath(%q) = %q, want %q", tt.segments, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIErrorDetail(t *testing.T) {
+	tests := map[string]struct {
+		body       string
+		wantNode   string
+		wantDetail string
+	}{
+		"description and hint": {
+			body:       `{"message":"Workflow could not be activated","description":"Missing credentials","hint":"Add a credential"}`,
+			wantDetail: "Workflow could not be activated: Missing credentials\nAdd a credential",
+		},
+		"node by name": {
+			body:       `{"message":"Invalid parameter","node":"HTTP Request"}`,
+			wantNode:   "HTTP Request",
+			wantDetail: `Invalid parameter (node "HTTP Request")`,
+		},
+		"node object": {
+			body:       `{"message":"Invalid parameter","node":{"name":"Webhook","type":"n8n-nodes-base.webhook"}}`,
+			wantNode:   "Webhook",
+			wantDetail: `Invalid parameter (node "Webhook")`,
+		},
+		"validation issues": {
+			body:       `{"message":"request/body is invalid","errors":[{"path":"/body/nodes/0/type","message":"must be string"}],"issues":[{"path":["name"],"message":"Required"}]}`,
+			wantDetail: "request/body is invalid\n  - nodes[0].type: must be string\n  - name: Required",
+		},
+		"issues without message": {
+			body:       `{"issues":[{"message":"Required"}]}`,
+			wantDetail: "Bad Request\n  - Required",
+		},
+		"raw body": {
+			body:       "upstream connect error",
+			wantDetail: "upstream connect error",
+		},
+		"empty body": {
+			wantDetail: "Bad Request",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := newAPIError(http.StatusBadRequest, []byte(tt.body))
+			if got.Node != tt.wantNode {
+				t.Errorf("Node = %q, want %q", got.Node, tt.wantNode)
+			}
+			if detail := got.Detail(); detail != tt.wantDetail {
+				t.Errorf("Detail() = %q, want %q", detail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestWorkflowError(t *testing.T) {
+	nodes := []interface{}{
+		map[string]interface{}{"name": "Webhook"},
+		map[string]interface{}{"name": "HTTP Request"},
+		"not a node",
+	}
+
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"issue in a node": {
+			err:  newAPIError(http.StatusBadRequest, []byte(`{"message":"invalid","errors":[{"path":"/body/nodes/1/parameters","message":"must be object"}]}`)),
+			want: "HTTP Request",
+		},
+		"node already named": {
+			err:  newAPIError(http.StatusBadRequest, []byte(`{"message":"invalid","node":"Webhook","errors":[{"path":"/body/nodes/1/parameters","message":"must be object"}]}`)),
+			want: "Webhook",
+		},
+		"index out of range": {
+			err: newAPIError(http.StatusBadRequest, []byte(`{"message":"invalid","errors":[{"path":"/body/nodes/5/name","message":"Required"}]}`)),
+		},
+		"node without name": {
+			err: newAPIError(http.StatusBadRequest, []byte(`{"message":"invalid","errors":[{"path":"/body/nodes/2/name","message":"Required"}]}`)),
+		},
+		"issue outside the nodes": {
+			err: newAPIError(http.StatusBadRequest, []byte(`{"message":"invalid","errors":[{"path":"/body/settings","message":"must be object"}]}`)),
+		},
+		"not an API error": {
+			err: errors.New("connection refused"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := workflowError(tt.err, nodes)
+			if err != tt.err {
+				t.Errorf("workflowError() = %v, want the same error", err)
+			}
+			if got := ErrorNode(err); got != tt.want {
+				t.Errorf("ErrorNode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
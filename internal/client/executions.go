@@ -0,0 +1,166 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Execution represents a workflow execution
+type Execution struct {
+	Data       *ExecutionData `json:"data,omitempty"`
+	ID         string         `json:"id"`
+	WorkflowID string         `json:"workflowId"`
+	Mode       string         `json:"mode"`
+	Status     string         `json:"status"`
+	StartedAt  string         `json:"startedAt"`
+	StoppedAt  string         `json:"stoppedAt,omitempty"`
+	Finished   bool           `json:"finished"`
+}
+
+// ExecutionData holds the run data of an execution. It is only returned when
+// the execution is requested with its data.
+type ExecutionData struct {
+	ResultData struct {
+		Error            map[string]interface{} `json:"error,omitempty"`
+		RunData          map[string][]NodeRun   `json:"runData"`
+		LastNodeExecuted string                 `json:"lastNodeExecuted,omitempty"`
+	} `json:"resultData"`
+}
+
+// NodeRun holds the output of one run of a node
+type NodeRun struct {
+	Data map[string][][]NodeOutputItem `json:"data"`
+}
+
+// NodeOutputItem is a single item produced by a node
+type NodeOutputItem struct {
+	JSON map[string]interface{} `json:"json"`
+}
+
+// IsRunning reports whether the execution has not reached a final status yet
+func (e *Execution) IsRunning() bool {
+	switch e.Status {
+	case "new", "running", "waiting":
+		return true
+	case "":
+		return !e.Finished && e.StoppedAt == ""
+	}
+	return false
+}
+
+// LastNodeOutput returns the items the last executed node emitted on its
+// first main output, or nil if the execution data is not available
+func (e *Execution) LastNodeOutput() []map[string]interface{} {
+	if e.Data == nil {
+		return nil
+	}
+
+	runs := e.Data.ResultData.RunData[e.Data.ResultData.LastNodeExecuted]
+	if len(runs) == 0 {
+		return nil
+	}
+
+	main := runs[len(runs)-1].Data["main"]
+	if len(main) == 0 {
+		return nil
+	}
+
+	items := make([]map[string]interface{}, 0, len(main[0]))
+	for _, item := range main[0] {
+		items = append(items, item.JSON)
+	}
+	return items
+}
+
+// RunWorkflow starts a manual execution of a workflow and returns the ID of
+// the execution
+func (c *Client) RunWorkflow(id string) (string, error) {
+	respBody, err := c.doRequest("POST", fmt.Sprintf("/api/v1/workflows/%s/run", id), map[string]interface{}{})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		ExecutionID string `json:"executionId"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result.ExecutionID, nil
+}
+
+// GetExecution retrieves an execution by ID, optionally including its run data
+func (c *Client) GetExecution(id string, includeData bool) (*Execution, error) {
+	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/executions/%s?includeData=%t", id, includeData), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Execution
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ExecutionFilter narrows down the executions returned by ListExecutions.
+// Empty fields are not filtered on.
+type ExecutionFilter struct {
+	WorkflowID string
+	Status     string
+	ProjectID  string
+	// Limit stops listing after this many executions; 0 lists all of them
+	Limit int
+}
+
+// ExecutionListResponse represents the response from listing executions
+type ExecutionListResponse struct {
+	Data       []Execution `json:"data"`
+	NextCursor string      `json:"nextCursor"`
+}
+
+// ListExecutions lists all executions matching the filter, newest first
+func (c *Client) ListExecutions(filter ExecutionFilter) ([]Execution, error) {
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+	if filter.WorkflowID != "" {
+		query.Set("workflowId", filter.WorkflowID)
+	}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+	if filter.ProjectID != "" {
+		query.Set("projectId", filter.ProjectID)
+	}
+
+	var executions []Execution
+	for {
+		respBody, err := c.doRequest("GET", "/api/v1/executions?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result ExecutionListResponse
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		executions = append(executions, result.Data...)
+		if filter.Limit > 0 && len(executions) >= filter.Limit {
+			return executions[:filter.Limit], nil
+		}
+		if result.NextCursor == "" {
+			return executions, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
+
+// DeleteExecution deletes an execution
+func (c *Client) DeleteExecution(id string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/executions/%s", id), nil)
+	return err
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ProjectRootFolderID refers to the top level of a project when moving the
+// contents of a deleted folder
+const ProjectRootFolderID = "0"
+
+// Folder represents a folder that organizes workflows within a project
+type Folder struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name"`
+	ParentFolderID string `json:"parentFolderId,omitempty"`
+	CreatedAt      string `json:"createdAt,omitempty"`
+	UpdatedAt      string `json:"updatedAt,omitempty"`
+}
+
+// CreateFolder creates a folder in a project
+func (c *Client) CreateFolder(projectID string, folder *Folder) (*Folder, error) {
+	respBody, err := c.doRequest("POST", fmt.Sprintf("/api/v1/projects/%s/folders", projectID), folder)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Folder
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetFolder retrieves a folder of a project by ID
+func (c *Client) GetFolder(projectID, id string) (*Folder, error) {
+	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/projects/%s/folders/%s", projectID, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Folder
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateFolder renames a folder or moves it to another parent folder
+func (c *Client) UpdateFolder(projectID, id string, folder *Folder) (*Folder, error) {
+	payload := map[string]interface{}{
+		"name": folder.Name,
+		// An empty parent moves the folder to the top level of the project
+		"parentFolderId": nil,
+	}
+	if folder.ParentFolderID != "" {
+		payload["parentFolderId"] = folder.ParentFolderID
+	}
+
+	respBody, err := c.doRequest("PATCH", fmt.Sprintf("/api/v1/projects/%s/folders/%s", projectID, id), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Folder
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteFolder deletes a folder and moves its workflows and subfolders into
+// the folder transferToID, or the top level of the project when it is
+// ProjectRootFolderID
+func (c *Client) DeleteFolder(projectID, id, transferToID string) error {
+	query := url.Values{}
+	query.Set("transferToFolderId", transferToID)
+
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/projects/%s/folders/%s?%s", projectID, id, query.Encode()), nil)
+	return err
+}
@@ -0,0 +1,12 @@
+package client
+
+// CheckConnection sends a cheap request to verify that the API is reachable
+// and accepts the configured credentials. An API key that lacks the scope of
+// the request is still accepted, as it did authenticate.
+func (c *Client) CheckConnection() error {
+	_, err := c.doRequest("GET", "/api/v1/workflows?limit=1", nil)
+	if IsForbidden(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// InsightsMetric represents a single metric in an insights summary
+type InsightsMetric struct {
+	Unit      string   `json:"unit"`
+	Value     float64  `json:"value"`
+	Deviation *float64 `json:"deviation"`
+}
+
+// InsightsSummary represents the instance-level insights summary
+type InsightsSummary struct {
+	Total          InsightsMetric `json:"total"`
+	Failed         InsightsMetric `json:"failed"`
+	FailureRate    InsightsMetric `json:"failureRate"`
+	TimeSaved      InsightsMetric `json:"timeSaved"`
+	AverageRunTime InsightsMetric `json:"averageRunTime"`
+}
+
+// GetInsightsSummary retrieves the insights summary for the given time range.
+// startDate and endDate are RFC 3339 timestamps; projectID is optional.
+func (c *Client) GetInsightsSummary(startDate, endDate, projectID string) (*InsightsSummary, error) {
+	query := url.Values{}
+	query.Set("startDate", startDate)
+	query.Set("endDate", endDate)
+	if projectID != "" {
+		query.Set("projectId", projectID)
+	}
+
+	respBody, err := c.doRequest("GET", "/api/v1/insights/summary?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result InsightsSummary
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
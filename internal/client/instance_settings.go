@@ -0,0 +1,97 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// InstanceSettings represents the instance-level settings that can be changed
+// through the API
+type InstanceSettings struct {
+	TelemetryEnabled             *bool    `json:"telemetryEnabled,omitempty"`
+	PersonalizationSurveyEnabled *bool    `json:"personalizationSurveyEnabled,omitempty"`
+	Timezone                     string   `json:"timezone,omitempty"`
+	Version                      string   `json:"versionCli,omitempty"`
+	DismissedBanners             []string `json:"dismissedBanners,omitempty"`
+}
+
+// GetInstanceSettings retrieves the instance settings
+func (c *Client) GetInstanceSettings() (*InstanceSettings, error) {
+	respBody, err := c.doRequest("GET", "/api/v1/settings", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result InstanceSettings
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateInstanceSettings changes the instance settings that are set in
+// settings; unset fields are left unchanged
+func (c *Client) UpdateInstanceSettings(settings *InstanceSettings) (*InstanceSettings, error) {
+	// The version is reported by n8n and cannot be changed
+	payload := *settings
+	payload.Version = ""
+
+	respBody, err := c.doRequest("PATCH", "/api/v1/settings", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result InstanceSettings
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// EnterpriseFeatures reports which licensed enterprise features are enabled
+type EnterpriseFeatures struct {
+	Sharing             bool `json:"sharing"`
+	LDAP                bool `json:"ldap"`
+	SAML                bool `json:"saml"`
+	SourceControl       bool `json:"sourceControl"`
+	LogStreaming        bool `json:"logStreaming"`
+	Variables           bool `json:"variables"`
+	ExternalSecrets     bool `json:"externalSecrets"`
+	AdvancedPermissions bool `json:"advancedPermissions"`
+	Projects            struct {
+		Team struct {
+			Limit int64 `json:"limit"`
+		} `json:"team"`
+	} `json:"projects"`
+}
+
+// TeamProjects reports whether team projects can be created
+func (f *EnterpriseFeatures) TeamProjects() bool {
+	// A limit of -1 means unlimited
+	return f.Projects.Team.Limit != 0
+}
+
+// InstanceInfo describes the n8n instance
+type InstanceInfo struct {
+	InstanceID string             `json:"instanceId"`
+	Version    string             `json:"versionCli"`
+	EditorURL  string             `json:"urlBaseEditor"`
+	Enterprise EnterpriseFeatures `json:"enterprise"`
+}
+
+// GetInstanceInfo retrieves the version and enabled features of the instance
+func (c *Client) GetInstanceInfo() (*InstanceInfo, error) {
+	respBody, err := c.doRequest("GET", "/api/v1/settings", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result InstanceInfo
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// LicenseQuota represents the usage and limit of a licensed quota
+type LicenseQuota struct {
+	Value int64 `json:"value"`
+	Limit int64 `json:"limit"`
+}
+
+// LicenseUsage represents the usage of the licensed quotas
+type LicenseUsage struct {
+	ActiveWorkflowTriggers LicenseQuota `json:"activeWorkflowTriggers"`
+}
+
+// LicenseDetails represents the plan of the active license
+type LicenseDetails struct {
+	PlanID   string   `json:"planId"`
+	PlanName string   `json:"planName"`
+	Features []string `json:"features,omitempty"`
+}
+
+// License represents the license state of an n8n instance
+type License struct {
+	Usage   LicenseUsage   `json:"usage"`
+	License LicenseDetails `json:"license"`
+}
+
+// activateLicenseRequest is the payload of the activation request
+type activateLicenseRequest struct {
+	ActivationKey string `json:"activationKey"`
+}
+
+// GetLicense retrieves the license state of the instance
+func (c *Client) GetLicense() (*License, error) {
+	respBody, err := c.doRequest("GET", "/api/v1/license", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result License
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ActivateLicense activates an enterprise license key on the instance
+func (c *Client) ActivateLicense(activationKey string) (*License, error) {
+	respBody, err := c.doRequest("POST", "/api/v1/license/activate", activateLicenseRequest{ActivationKey: activationKey})
+	if err != nil {
+		return nil, err
+	}
+
+	var result License
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Log streaming destination types as understood by the n8n event bus
+const (
+	LogStreamingTypeWebhook = "$$MessageEventBusDestinationWebhook"
+	LogStreamingTypeSyslog  = "$$MessageEventBusDestinationSyslog"
+	LogStreamingTypeSentry  = "$$MessageEventBusDestinationSentry"
+)
+
+// LogStreamingHeader represents a header sent by a webhook destination
+type LogStreamingHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// LogStreamingHeaderParameters wraps the headers of a webhook destination
+type LogStreamingHeaderParameters struct {
+	Parameters []LogStreamingHeader `json:"parameters"`
+}
+
+// LogStreamingDestination represents an event bus (log streaming) destination.
+// Only the fields relevant to the destination type are set.
+type LogStreamingDestination struct {
+	HeaderParameters       *LogStreamingHeaderParameters `json:"headerParameters,omitempty"`
+	ID                     string                        `json:"id,omitempty"`
+	Type                   string                        `json:"__type"`
+	Label                  string                        `json:"label,omitempty"`
+	URL                    string                        `json:"url,omitempty"`
+	Method                 string                        `json:"method,omitempty"`
+	DSN                    string                        `json:"dsn,omitempty"`
+	Host                   string                        `json:"host,omitempty"`
+	Protocol               string                        `json:"protocol,omitempty"`
+	AppName                string                        `json:"app_name,omitempty"`
+	SubscribedEvents       []string                      `json:"subscribedEvents"`
+	Port                   int64                         `json:"port,omitempty"`
+	Facility               int64                         `json:"facility,omitempty"`
+	Enabled                bool                          `json:"enabled"`
+	AnonymizeAuditMessages bool                          `json:"anonymizeAuditMessages"`
+	SendHeaders            bool                          `json:"sendHeaders,omitempty"`
+}
+
+// GetLogStreamingDestination retrieves a log streaming destination by ID
+func (c *Client) GetLogStreamingDestination(id string) (*LogStreamingDestination, error) {
+	respBody, err := c.doRequest("GET", "/api/v1/eventbus/destination?id="+url.QueryEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []LogStreamingDestination
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	for i := range result {
+		if result[i].ID == id {
+			return &result[i], nil
+		}
+	}
+
+	return nil, notFoundError("log streaming destination %s not found", id)
+}
+
+// SaveLogStreamingDestination creates a log streaming destination, or replaces
+// the existing one when destination.ID is set
+func (c *Client) SaveLogStreamingDestination(destination *LogStreamingDestination) (*LogStreamingDestination, error) {
+	respBody, err := c.doRequest("POST", "/api/v1/eventbus/destination", destination)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LogStreamingDestination
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteLogStreamingDestination deletes a log streaming destination
+func (c *Client) DeleteLogStreamingDestination(id string) error {
+	_, err := c.doRequest("DELETE", "/api/v1/eventbus/destination?id="+url.QueryEscape(id), nil)
+	return err
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogHTTPBodiesEnv is the environment variable that adds the request and
+// response bodies to the debug log of each request
+const LogHTTPBodiesEnv = "N8N_TF_LOG_HTTP_BODIES"
+
+// maxLoggedBodySize is the length after which logged bodies are cut off
+const maxLoggedBodySize = 64 << 10
+
+// redactedValue replaces secrets in logged bodies
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeys are the lowercased JSON keys whose values are never logged,
+// such as the passwords of users
+var sensitiveKeys = map[string]bool{
+	"password":      true,
+	"apikey":        true,
+	"rawapikey":     true,
+	"token":         true,
+	"accesstoken":   true,
+	"refreshtoken":  true,
+	"secret":        true,
+	"clientsecret":  true,
+	"privatekey":    true,
+	"authorization": true,
+	"cookie":        true,
+}
+
+// newRequestID returns a random ID that is sent with a request in the
+// X-Request-Id header and logged with it, so that the logs of the provider,
+// of proxies and of n8n can be matched
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// logRequest writes a debug log entry for a request. Bodies are only logged
+// when LogHTTPBodiesEnv is set, and always with their secrets redacted.
+func (c *Client) logRequest(method, path, requestID string, status int, duration time.Duration, reqBody, respBody []byte, err error) {
+	ctx := c.context()
+	for _, secret := range []string{c.APIKey, c.BearerToken, c.BasicAuthPassword} {
+		if secret != "" {
+			ctx = tflog.MaskAllFieldValuesStrings(ctx, secret)
+			ctx = tflog.MaskMessageStrings(ctx, secret)
+		}
+	}
+
+	fields := map[string]interface{}{
+		"http_method":      method,
+		"http_path":        path,
+		"http_duration_ms": duration.Milliseconds(),
+		"request_id":       requestID,
+	}
+	if status != 0 {
+		fields["http_status"] = status
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	if logHTTPBodies() {
+		if len(reqBody) > 0 {
+			fields["http_request_body"] = redactBody(reqBody)
+		}
+		if len(respBody) > 0 {
+			fields["http_response_body"] = redactBody(respBody)
+		}
+	}
+
+	tflog.Debug(ctx, "n8n API request", fields)
+}
+
+// logHTTPBodies reports whether LogHTTPBodiesEnv enables body logging
+func logHTTPBodies() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(LogHTTPBodiesEnv))
+	return enabled
+}
+
+// redactBody returns a body for logging with the values of sensitive keys
+// replaced. Bodies that are not JSON cannot be redacted and are left out.
+func redactBody(body []byte) string {
+	var parsed interface{}
+	if json.Unmarshal(body, &parsed) != nil {
+		return "[" + strconv.Itoa(len(body)) + " bytes of non-JSON content]"
+	}
+
+	redacted, err := json.Marshal(redactValue(parsed))
+	if err != nil {
+		return redactedValue
+	}
+	if len(redacted) > maxLoggedBodySize {
+		return string(redacted[:maxLoggedBodySize]) + "...(truncated)"
+	}
+	return string(redacted)
+}
+
+// redactValue replaces the values of sensitive keys in a decoded JSON value
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			// Credential data is an object under "data", while lists keep
+			// their items in an array under the same key
+			_, isObject := item.(map[string]interface{})
+			if sensitiveKeys[strings.ToLower(key)] || (key == "data" && isObject) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NodeType describes a node type installed on the instance
+type NodeType struct {
+	Name string `json:"name"`
+	// Version is a single version number or a list of the supported versions
+	Version json.RawMessage `json:"version"`
+}
+
+// Versions returns the type versions the node type supports
+func (t *NodeType) Versions() []float64 {
+	var version float64
+	if err := json.Unmarshal(t.Version, &version); err == nil {
+		return []float64{version}
+	}
+
+	var versions []float64
+	if err := json.Unmarshal(t.Version, &versions); err == nil {
+		return versions
+	}
+	return nil
+}
+
+// ListNodeTypes lists the node types installed on the instance, including
+// community nodes. n8n publishes them for the editor rather than through the
+// public API.
+func (c *Client) ListNodeTypes() ([]NodeType, error) {
+	respBody, err := c.doRequest("GET", "/types/nodes.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []NodeType
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
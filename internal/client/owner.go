@@ -0,0 +1,124 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// OwnerSetupRequest represents the initial owner account of a new instance
+type OwnerSetupRequest struct {
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Password  string `json:"password"`
+}
+
+// OwnerSession is the browser session of the owner created by SetupOwner. It
+// is used to bootstrap an API key, which the public API cannot do on its own.
+type OwnerSession struct {
+	User    User
+	cookies []*http.Cookie
+}
+
+// SetupOwner creates the owner account of an instance that has not been set
+// up yet. The endpoint is unauthenticated, so no API key is needed.
+func (c *Client) SetupOwner(req *OwnerSetupRequest) (*OwnerSession, error) {
+	respBody, cookies, err := c.doSessionRequest("POST", "/rest/owner/setup", req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data User `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &OwnerSession{User: result.Data, cookies: cookies}, nil
+}
+
+// CreateSessionAPIKey creates an API key for the user of an owner session
+// and returns it including the full key
+func (c *Client) CreateSessionAPIKey(session *OwnerSession, req *CreateAPIKeyRequest) (*APIKey, error) {
+	respBody, _, err := c.doSessionRequest("POST", "/rest/api-keys", req, session.cookies)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data APIKey `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result.Data, nil
+}
+
+// doSessionRequest performs an HTTP request against the internal REST API
+// using cookie authentication instead of the API key
+func (c *Client) doSessionRequest(method, path string, body interface{}, cookies []*http.Cookie) ([]byte, []*http.Cookie, error) {
+	var reqBody io.Reader
+	var jsonBody []byte
+	if body != nil {
+		var err error
+		jsonBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewBuffer(jsonBody)
+	}
+
+	url := fmt.Sprintf("%s%s", c.BaseURL, path)
+	req, err := http.NewRequestWithContext(c.context(), method, url, reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	requestID := newRequestID()
+	c.setExtraHeaders(req)
+	c.setAuthHeaders(req)
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Request-Id", requestID)
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+
+	release, err := c.acquireSlot()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer release()
+
+	start := time.Now()
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		err = fmt.Errorf("failed to execute request: %w", err)
+		c.logRequest(method, path, requestID, 0, time.Since(start), jsonBody, nil, err)
+		return nil, nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		err = fmt.Errorf("failed to read response body: %w", err)
+		c.logRequest(method, path, requestID, resp.StatusCode, time.Since(start), jsonBody, nil, err)
+		return nil, nil, err
+	}
+	c.logRequest(method, path, requestID, resp.StatusCode, time.Since(start), jsonBody, respBody, nil)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, nil, newAPIError(resp.StatusCode, respBody)
+	}
+
+	return respBody, resp.Cookies(), nil
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Project represents an n8n project
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ProjectListResponse represents the response from listing projects
+type ProjectListResponse struct {
+	Data       []Project `json:"data"`
+	NextCursor string    `json:"nextCursor"`
+}
+
+// ListProjects lists all projects
+func (c *Client) ListProjects() ([]Project, error) {
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+
+	var projects []Project
+	for {
+		respBody, err := c.doRequest("GET", "/api/v1/projects?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result ProjectListResponse
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		projects = append(projects, result.Data...)
+		if result.NextCursor == "" {
+			return projects, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
+
+// CreateProject creates a team project
+func (c *Client) CreateProject(name string) (*Project, error) {
+	respBody, err := c.doRequest("POST", "/api/v1/projects", map[string]string{"name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, &project); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+	if project.ID != "" {
+		return &project, nil
+	}
+
+	// Older n8n versions answer without the created project
+	projects, err := c.ListProjects()
+	if err != nil {
+		return nil, err
+	}
+	for i := range projects {
+		if projects[i].Name == name && projects[i].Type == "team" {
+			return &projects[i], nil
+		}
+	}
+	return nil, fmt.Errorf("project %q was created but is not listed", name)
+}
+
+// DeleteProject deletes a team project
+func (c *Client) DeleteProject(id string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/projects/%s", id), nil)
+	return err
+}
+
+// PersonalProjectID returns the ID of the personal project of a user. n8n
+// names personal projects after their owner, as "First Last <email>".
+func (c *Client) PersonalProjectID(userID string) (string, error) {
+	user, err := c.GetUser(userID)
+	if err != nil {
+		return "", err
+	}
+
+	projects, err := c.ListProjects()
+	if err != nil {
+		return "", err
+	}
+
+	for _, project := range projects {
+		if project.Type == "personal" && strings.HasSuffix(project.Name, "<"+user.Email+">") {
+			return project.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no personal project found for user %s", userID)
+}
+
+// ProjectRelation represents a user's role in a project
+type ProjectRelation struct {
+	UserID string `json:"userId"`
+	Role   string `json:"role"`
+}
+
+// AddProjectUser adds a user to a project with the given role
+func (c *Client) AddProjectUser(projectID, userID, role string) error {
+	payload := map[string]interface{}{
+		"relations": []ProjectRelation{
+			{UserID: userID, Role: role},
+		},
+	}
+
+	_, err := c.doRequest("POST", fmt.Sprintf("/api/v1/projects/%s/users", projectID), payload)
+	return err
+}
+
+// UpdateProjectUserRole changes the role of a user in a project
+func (c *Client) UpdateProjectUserRole(projectID, userID, role string) error {
+	payload := map[string]string{
+		"role": role,
+	}
+
+	_, err := c.doRequest("PATCH", fmt.Sprintf("/api/v1/projects/%s/users/%s", projectID, userID), payload)
+	return err
+}
+
+// RemoveProjectUser removes a user from a project
+func (c *Client) RemoveProjectUser(projectID, userID string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/projects/%s/users/%s", projectID, userID), nil)
+	return err
+}
+
+// ProjectMember is the membership of a user in a project
+type ProjectMember struct {
+	UserID string
+	Email  string
+	// Role is the project role, e.g. project:editor; empty when the n8n
+	// version does not report it
+	Role string
+}
+
+// ListProjectMembers lists the members of a project. n8n versions without
+// an endpoint for the members of a project are asked for the users of the
+// project instead, which does not include their project role.
+func (c *Client) ListProjectMembers(projectID string) ([]ProjectMember, error) {
+	members, err := c.listProjectMembers(projectID)
+	if !IsNotFound(err) {
+		return members, err
+	}
+
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+	query.Set("projectId", projectID)
+
+	members = nil
+	for {
+		respBody, err := c.doRequest("GET", "/api/v1/users?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result UserListResponse
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		for _, user := range result.Data {
+			members = append(members, ProjectMember{UserID: user.ID, Email: user.Email})
+		}
+		if result.NextCursor == "" {
+			return members, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
+
+// listProjectMembers lists the members of a project with their project role
+func (c *Client) listProjectMembers(projectID string) ([]ProjectMember, error) {
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+
+	var members []ProjectMember
+	for {
+		respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/projects/%s/users?%s", projectID, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result struct {
+			Data []struct {
+				ID     string          `json:"id"`
+				UserID string          `json:"userId"`
+				Email  string          `json:"email"`
+				Role   json.RawMessage `json:"role"`
+			} `json:"data"`
+			NextCursor string `json:"nextCursor"`
+		}
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		for _, member := range result.Data {
+			userID := member.UserID
+			if userID == "" {
+				userID = member.ID
+			}
+			members = append(members, ProjectMember{UserID: userID, Email: member.Email, Role: roleSlug(member.Role)})
+		}
+		if result.NextCursor == "" {
+			return members, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
+
+// roleSlug reads a role, given by its slug or as the role object itself
+func roleSlug(raw json.RawMessage) string {
+	var slug string
+	if json.Unmarshal(raw, &slug) == nil {
+		return slug
+	}
+
+	var role struct {
+		Slug string `json:"slug"`
+	}
+	if json.Unmarshal(raw, &role) == nil {
+		return role.Slug
+	}
+	return ""
+}
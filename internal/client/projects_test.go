@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectMembers(t *testing.T) {
+	tests := map[string]struct {
+		responses map[string]string
+		want      []ProjectMember
+	}{
+		"members endpoint": {
+			responses: map[string]string{
+				"/api/v1/projects/p1/users": `{"data":[{"id":"u1","email":"a@example.com","role":"project:admin"},{"userId":"u2","role":{"slug":"project:viewer"}}]}`,
+			},
+			want: []ProjectMember{
+				{UserID: "u1", Email: "a@example.com", Role: "project:admin"},
+				{UserID: "u2", Role: "project:viewer"},
+			},
+		},
+		"users filtered by project": {
+			responses: map[string]string{
+				"/api/v1/users": `{"data":[{"id":"u1","email":"a@example.com","role":"global:member"}]}`,
+			},
+			want: []ProjectMember{{UserID: "u1", Email: "a@example.com"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, ok := tt.responses[r.URL.Path]
+				if !ok {
+					http.NotFound(w, r)
+					return
+				}
+				if r.URL.Path == "/api/v1/users" && r.URL.Query().Get("projectId") != "p1" {
+					t.Errorf("users are not filtered by project: %s", r.URL.RawQuery)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if _, err := w.Write([]byte(body)); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer server.Close()
+
+			got, err := NewClient(server.URL, "key").ListProjectMembers("p1")
+			if err != nil {
+				t.Fatalf("ListProjectMembers() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListProjectMembers() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimiter spaces out requests to at most a fixed number per second. It is
+// shared by the copies WithContext makes.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newRateLimiter returns a limiter allowing requestsPerSecond requests per
+// second, or nil for no limit
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / requestsPerSecond)}
+}
+
+// wait blocks until the next request may be sent. It returns the error of
+// ctx if ctx ends first.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	// Reserve the next slot, then wait for it outside the lock
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(slot))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// SetRequestsPerSecond limits the rate of requests to the API. Zero removes
+// the limit.
+func (c *Client) SetRequestsPerSecond(requestsPerSecond float64) {
+	c.limiter = newRateLimiter(requestsPerSecond)
+}
+
+// retryAfter returns how long the Retry-After header of a response asks to
+// wait, given in seconds or as a date, or 0 if it is missing
+func retryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+// SetMaxConcurrentRequests limits how many requests are in flight at once,
+// across all resources. Zero removes the limit.
+func (c *Client) SetMaxConcurrentRequests(maxConcurrent int) {
+	c.inFlight = nil
+	if maxConcurrent > 0 {
+		c.inFlight = make(chan struct{}, maxConcurrent)
+	}
+}
+
+// acquireSlot blocks until fewer than the maximum number of requests are in
+// flight. The returned function frees the slot again. It returns the error
+// of the request context if that ends first.
+func (c *Client) acquireSlot() (func(), error) {
+	if c.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.inFlight <- struct{}{}:
+		return func() { <-c.inFlight }, nil
+	case <-c.context().Done():
+		return nil, c.context().Err()
+	}
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		"missing": {},
+		"seconds": {
+			value:   "7",
+			wantMin: 7 * time.Second,
+			wantMax: 7 * time.Second,
+		},
+		"zero seconds": {
+			value: "0",
+		},
+		"negative seconds": {
+			value: "-5",
+		},
+		"date": {
+			value:   time.Now().Add(time.Minute).UTC().Format(http.TimeFormat),
+			wantMin: 58 * time.Second,
+			wantMax: time.Minute,
+		},
+		"past date": {
+			value:   time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat),
+			wantMin: -62 * time.Second,
+			wantMax: -58 * time.Second,
+		},
+		"invalid": {
+			value: "soon",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.value != "" {
+				header.Set("Retry-After", tt.value)
+			}
+
+			got := retryAfter(header)
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("retryAfter(%q) = %s, want between %s and %s", tt.value, got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestRateLimiterSpacesRequests(t *testing.T) {
+	limiter := newRateLimiter(20)
+	start := time.Now()
+	for range 3 {
+		if err := limiter.wait(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first request goes out at once, the next two 50ms apart
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("3 requests at 20 per second took %s, want at least 100ms", elapsed)
+	}
+	if newRateLimiter(0) != nil {
+		t.Error("newRateLimiter(0) is not nil")
+	}
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/vcr"
+)
+
+// cassettes is the directory the recorded responses of n8n are kept in
+const cassettes = "testdata/cassettes"
+
+func TestReplayWorkflowLifecycle(t *testing.T) {
+	vcr.Run(t, cassettes, "workflow_lifecycle.json", func(t *testing.T, s *vcr.Server) {
+		c := NewClient(s.URL, "replay")
+
+		created, err := c.CreateWorkflow(&Workflow{
+			Name: "Orders",
+			Nodes: []interface{}{map[string]interface{}{
+				"id":          "5b0c2f4e-5b5a-4a0e-9d3c-1f6f3b1c2a10",
+				"name":        "Webhook",
+				"type":        "n8n-nodes-base.webhook",
+				"typeVersion": 2,
+				"position":    []interface{}{0, 0},
+				"webhookId":   "orders",
+				"parameters":  map[string]interface{}{"path": "orders", "httpMethod": "POST"},
+			}},
+			Connections: map[string]interface{}{},
+			Settings:    map[string]interface{}{"executionOrder": "v1"},
+		})
+		if err != nil {
+			t.Fatalf("CreateWorkflow() error = %v", err)
+		}
+		if created.ID == "" || created.Active || len(created.Nodes) != 1 {
+			t.Errorf("CreateWorkflow() = %+v", created)
+		}
+		if created.OwnerProjectID() == "" {
+			t.Error("CreateWorkflow() did not report the owner project")
+		}
+
+		activated, err := c.ActivateWorkflow(created.ID)
+		if err != nil {
+			t.Fatalf("ActivateWorkflow() error = %v", err)
+		}
+		if !activated.Active {
+			t.Error("ActivateWorkflow() returned an inactive workflow")
+		}
+
+		read, err := c.GetWorkflow(created.ID)
+		if err != nil {
+			t.Fatalf("GetWorkflow() error = %v", err)
+		}
+		if !read.Active || read.Name != "Orders" {
+			t.Errorf("GetWorkflow() = %+v", read)
+		}
+
+		if _, err := c.DeactivateWorkflow(created.ID); err != nil {
+			t.Fatalf("DeactivateWorkflow() error = %v", err)
+		}
+		if err := c.DeleteWorkflow(created.ID); err != nil {
+			t.Fatalf("DeleteWorkflow() error = %v", err)
+		}
+
+		_, err = c.GetWorkflow(created.ID)
+		if !IsNotFound(err) {
+			t.Errorf("GetWorkflow() after delete error = %v, want not found", err)
+		}
+	})
+}
+
+func TestReplayActivationError(t *testing.T) {
+	vcr.Run(t, cassettes, "workflow_activation_error.json", func(t *testing.T, s *vcr.Server) {
+		c := NewClient(s.URL, "replay")
+
+		_, err := c.ActivateWorkflow("Ue2zH8Lr6qIiYF1b")
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("ActivateWorkflow() error = %v, want an API error", err)
+		}
+		if !strings.Contains(err.Error(), "no node to start the workflow") {
+			t.Errorf("ActivateWorkflow() error = %q, want the reason n8n gave", err)
+		}
+	})
+}
+
+func TestReplayCredentialSecrets(t *testing.T) {
+	vcr.Run(t, cassettes, "credential_create.json", func(t *testing.T, s *vcr.Server) {
+		c := NewClient(s.URL, "replay")
+
+		// The password is only checked against the redacted recording
+		created, err := c.CreateCredential(&Credential{
+			Name: "Mail",
+			Type: "smtp",
+			Data: map[string]interface{}{"user": "ops@example.com", "password": "hunter2", "host": "smtp.example.com", "port": 465, "secure": true},
+		})
+		if err != nil {
+			t.Fatalf("CreateCredential() error = %v", err)
+		}
+		if created.ID == "" || created.Type != "smtp" {
+			t.Errorf("CreateCredential() = %+v", created)
+		}
+
+		if err := c.DeleteCredential(created.ID); err != nil {
+			t.Fatalf("DeleteCredential() error = %v", err)
+		}
+	})
+}
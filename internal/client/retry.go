@@ -0,0 +1,85 @@
+package client
+
+import (
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Defaults for retrying requests that failed with a transient error
+const (
+	defaultMaxRetries    = 3
+	defaultRetryMaxDelay = 30 * time.Second
+	retryBaseDelay       = 500 * time.Millisecond
+)
+
+// retryable reports whether a request that failed with the given status or
+// error can be sent again. Requests that may have been processed by n8n are
+// only repeated if they are idempotent.
+func (c *Client) retryable(method string, status int, err error) bool {
+	if err != nil {
+		return c.context().Err() == nil && (idempotentMethod(method) || notSent(err))
+	}
+
+	switch status {
+	case http.StatusTooManyRequests:
+		// Rate limited requests are rejected before they are processed
+		return true
+	case http.StatusServiceUnavailable:
+		// n8n is starting up, or a proxy has no backend for it
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotentMethod(method)
+	}
+	return false
+}
+
+// idempotentMethod reports whether repeating a request has the same effect
+// as sending it once
+func idempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// notSent reports whether a request failed before it reached the server, so
+// that even a non-idempotent request can be sent again
+func notSent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryDelay returns how long to wait before the given retry, starting at 1:
+// an exponential backoff with jitter, capped at the maximum delay
+func (c *Client) retryDelay(retry int) time.Duration {
+	maxDelay := c.RetryMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+
+	delay := maxDelay
+	if retry < 32 && retryBaseDelay<<(retry-1) < maxDelay {
+		delay = retryBaseDelay << (retry - 1)
+	}
+
+	// Spread out the retries of concurrent requests
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// waitForRetry sleeps before the given retry, at least for the delay the
+// server asked for. It returns false if the request context ends first.
+func (c *Client) waitForRetry(retry int, minDelay time.Duration) bool {
+	timer := time.NewTimer(max(c.retryDelay(retry), minDelay))
+	defer timer.Stop()
+
+	select {
+	case <-c.context().Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
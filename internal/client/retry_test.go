@@ -0,0 +1,88 @@
+package client
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := map[string]struct {
+		retry    int
+		maxDelay time.Duration
+		want     time.Duration
+	}{
+		"first retry": {
+			retry: 1,
+			want:  500 * time.Millisecond,
+		},
+		"third retry": {
+			retry: 3,
+			want:  2 * time.Second,
+		},
+		"capped": {
+			retry: 10,
+			want:  defaultRetryMaxDelay,
+		},
+		"shift overflow": {
+			retry: 64,
+			want:  defaultRetryMaxDelay,
+		},
+		"custom maximum": {
+			retry:    3,
+			maxDelay: time.Second,
+			want:     time.Second,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient("http://n8n.example.com", "key")
+			if tt.maxDelay > 0 {
+				c.RetryMaxDelay = tt.maxDelay
+			}
+
+			// The jitter keeps the delay between half and all of the backoff
+			for range 100 {
+				got := c.retryDelay(tt.retry)
+				if got < tt.want/2 || got > tt.want {
+					t.Fatalf("retryDelay(%d) = %s, want between %s and %s", tt.retry, got, tt.want/2, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}
+
+	tests := map[string]struct {
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		"rate limited post":       {method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		"unavailable post":        {method: http.MethodPost, status: http.StatusServiceUnavailable, want: true},
+		"bad gateway get":         {method: http.MethodGet, status: http.StatusBadGateway, want: true},
+		"bad gateway post":        {method: http.MethodPost, status: http.StatusBadGateway},
+		"gateway timeout delete":  {method: http.MethodDelete, status: http.StatusGatewayTimeout, want: true},
+		"server error":            {method: http.MethodGet, status: http.StatusInternalServerError},
+		"not found":               {method: http.MethodGet, status: http.StatusNotFound},
+		"connection refused post": {method: http.MethodPost, err: dialErr, want: true},
+		"connection reset get":    {method: http.MethodGet, err: readErr, want: true},
+		"connection reset post":   {method: http.MethodPost, err: readErr},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient("http://n8n.example.com", "key")
+			if got := c.retryable(tt.method, tt.status, tt.err); got != tt.want {
+				t.Errorf("retryable(%s, %d, %v) = %t, want %t", tt.method, tt.status, tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Role represents a built-in or custom n8n role
+type Role struct {
+	Slug        string   `json:"slug,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Description string   `json:"description,omitempty"`
+	RoleType    string   `json:"roleType"`
+	Scopes      []string `json:"scopes"`
+	SystemRole  bool     `json:"systemRole,omitempty"`
+}
+
+// ListRoles retrieves all roles, optionally limited to one role type
+// (global, project, workflow or credential)
+func (c *Client) ListRoles(roleType string) ([]Role, error) {
+	path := "/api/v1/roles"
+	if roleType != "" {
+		path += "?type=" + url.QueryEscape(roleType)
+	}
+
+	respBody, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Role
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetRole retrieves a role by slug
+func (c *Client) GetRole(slug string) (*Role, error) {
+	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/roles/%s", url.PathEscape(slug)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Role
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateRole creates a custom role; n8n generates the slug
+func (c *Client) CreateRole(role *Role) (*Role, error) {
+	payload := map[string]interface{}{
+		"displayName": role.DisplayName,
+		"description": role.Description,
+		"roleType":    role.RoleType,
+		"scopes":      role.Scopes,
+	}
+
+	respBody, err := c.doRequest("POST", "/api/v1/roles", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Role
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateRole updates the name, description and scopes of a custom role
+func (c *Client) UpdateRole(slug string, role *Role) (*Role, error) {
+	payload := map[string]interface{}{
+		"displayName": role.DisplayName,
+		"description": role.Description,
+		"scopes":      role.Scopes,
+	}
+
+	respBody, err := c.doRequest("PATCH", fmt.Sprintf("/api/v1/roles/%s", url.PathEscape(slug)), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Role
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteRole deletes a custom role
+func (c *Client) DeleteRole(slug string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/roles/%s", url.PathEscape(slug)), nil)
+	return err
+}
@@ -0,0 +1,190 @@
+package client
+
+// The interfaces below group the methods of Client by the part of the n8n API
+// they use. Code that only needs one part can depend on its interface, so
+// that it can be given a fake implementation instead of a live instance.
+
+// WorkflowsService manages workflows and their activation, tags and owners
+type WorkflowsService interface {
+	CreateWorkflow(workflow *Workflow) (*Workflow, error)
+	GetWorkflow(id string) (*Workflow, error)
+	GetWorkflowExcludingPinnedData(id string) (*Workflow, error)
+	RefreshWorkflow(id string) (*Workflow, error)
+	RefreshWorkflowExcludingPinnedData(id string) (*Workflow, error)
+	UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error)
+	DeleteWorkflow(id string) error
+	ArchiveWorkflow(id string) error
+	ActivateWorkflow(id string) (*Workflow, error)
+	DeactivateWorkflow(id string) (*Workflow, error)
+	TransferWorkflow(id, projectID string) error
+	UpdateWorkflowTags(id string, tags []map[string]string) error
+	GetWorkflowTags(id string) ([]Tag, error)
+	SetWorkflowTags(id string, tagIDs []string) ([]Tag, error)
+	ListWorkflows() ([]Workflow, error)
+	SearchWorkflows(filter WorkflowFilter) ([]Workflow, error)
+	PinnedDataExcluded() bool
+}
+
+// ExecutionsService runs workflows and manages their executions
+type ExecutionsService interface {
+	RunWorkflow(id string) (string, error)
+	GetExecution(id string, includeData bool) (*Execution, error)
+	ListExecutions(filter ExecutionFilter) ([]Execution, error)
+	DeleteExecution(id string) error
+}
+
+// CredentialsService manages credentials and whom they are shared with
+type CredentialsService interface {
+	CreateCredential(credential *Credential) (*Credential, error)
+	GetCredential(id string) (*Credential, error)
+	DeleteCredential(id string) error
+	TransferCredential(id, projectID string) error
+	TestCredential(credential *Credential) (*CredentialTestResult, error)
+	ListCredentials() ([]Credential, error)
+	RefreshCredential(id string) (*Credential, error)
+	ShareCredential(id string, projectIDs []string) error
+}
+
+// UsersService manages users and their invitations
+type UsersService interface {
+	InviteUsers(invitations []UserInvitation) ([]CreateUserResponse, error)
+	CreateUser(user *User) (*User, error)
+	ResendInvitation(email, role string) (string, error)
+	GetUser(id string) (*User, error)
+	UpdateUser(id string, user *User) (*User, error)
+	DeleteUser(id string) error
+	DeleteUserWithTransfer(id, projectID string) error
+	ListUsers() ([]User, error)
+}
+
+// TagsService manages tags
+type TagsService interface {
+	ListTags() ([]Tag, error)
+	CreateTag(name string) (*Tag, error)
+}
+
+// ProjectsService manages projects, their members and their folders
+type ProjectsService interface {
+	ListProjects() ([]Project, error)
+	CreateProject(name string) (*Project, error)
+	DeleteProject(id string) error
+	PersonalProjectID(userID string) (string, error)
+	AddProjectUser(projectID, userID, role string) error
+	UpdateProjectUserRole(projectID, userID, role string) error
+	RemoveProjectUser(projectID, userID string) error
+	ListProjectMembers(projectID string) ([]ProjectMember, error)
+	CreateFolder(projectID string, folder *Folder) (*Folder, error)
+	GetFolder(projectID, id string) (*Folder, error)
+	UpdateFolder(projectID, id string, folder *Folder) (*Folder, error)
+	DeleteFolder(projectID, id, transferToID string) error
+}
+
+// RolesService manages global and project roles
+type RolesService interface {
+	ListRoles(roleType string) ([]Role, error)
+	GetRole(slug string) (*Role, error)
+	CreateRole(role *Role) (*Role, error)
+	UpdateRole(slug string, role *Role) (*Role, error)
+	DeleteRole(slug string) error
+}
+
+// SourceControlService manages the connection to a Git repository
+type SourceControlService interface {
+	GetSourceControlPreferences() (*SourceControlPreferences, error)
+	SetSourceControlPreferences(preferences *SourceControlPreferences) (*SourceControlPreferences, error)
+	GenerateSourceControlKeyPair(keyGeneratorType string) (*SourceControlPreferences, error)
+	DisconnectSourceControl(keepKeyPair bool) error
+	PullSourceControl(force bool) (*SourceControlPullResult, error)
+}
+
+// InstanceService manages the settings of the instance itself and reports
+// what it is running
+type InstanceService interface {
+	CheckConnection() error
+	GetInstanceSettings() (*InstanceSettings, error)
+	UpdateInstanceSettings(settings *InstanceSettings) (*InstanceSettings, error)
+	GetInstanceInfo() (*InstanceInfo, error)
+	ListNodeTypes() ([]NodeType, error)
+}
+
+// OwnerService sets up the owner account of a new instance
+type OwnerService interface {
+	SetupOwner(req *OwnerSetupRequest) (*OwnerSession, error)
+	CreateSessionAPIKey(session *OwnerSession, req *CreateAPIKeyRequest) (*APIKey, error)
+}
+
+// LicenseService reads and activates the license of the instance
+type LicenseService interface {
+	GetLicense() (*License, error)
+	ActivateLicense(activationKey string) (*License, error)
+}
+
+// SAMLService manages single sign-on through SAML
+type SAMLService interface {
+	GetSAMLConfig() (*SAMLConfig, error)
+	SetSAMLConfig(config *SAMLConfig) (*SAMLConfig, error)
+	ToggleSAMLLogin(enabled bool) error
+}
+
+// LogStreamingService manages the destinations events are streamed to
+type LogStreamingService interface {
+	GetLogStreamingDestination(id string) (*LogStreamingDestination, error)
+	SaveLogStreamingDestination(destination *LogStreamingDestination) (*LogStreamingDestination, error)
+	DeleteLogStreamingDestination(id string) error
+}
+
+// CommunityPackagesService installs and updates community node packages
+type CommunityPackagesService interface {
+	GetCommunityPackages() ([]CommunityPackage, error)
+	GetCommunityPackage(name string) (*CommunityPackage, error)
+	InstallCommunityPackage(name, version string) (*CommunityPackage, error)
+	UpdateCommunityPackage(name, version string) (*CommunityPackage, error)
+	UninstallCommunityPackage(name string) error
+}
+
+// AuditService generates security audits
+type AuditService interface {
+	GenerateAudit(options AuditOptions) (map[string]AuditReport, error)
+}
+
+// InsightsService reports insights about workflow executions
+type InsightsService interface {
+	GetInsightsSummary(startDate, endDate, projectID string) (*InsightsSummary, error)
+}
+
+// APIKeysService manages the API keys of the authenticated user
+type APIKeysService interface {
+	GetAPIKeys() ([]APIKey, error)
+	GetAPIKey(id string) (*APIKey, error)
+	CreateAPIKey(req *CreateAPIKeyRequest) (*APIKey, error)
+	UpdateAPIKey(id string, req *UpdateAPIKeyRequest) error
+	DeleteAPIKey(id string) error
+}
+
+// Endpoints reports the URLs the instance is reached at
+type Endpoints interface {
+	APIEndpoint() string
+	WebhookEndpoint() string
+}
+
+// Ensure Client implements every service.
+var (
+	_ WorkflowsService         = (*Client)(nil)
+	_ ExecutionsService        = (*Client)(nil)
+	_ CredentialsService       = (*Client)(nil)
+	_ UsersService             = (*Client)(nil)
+	_ TagsService              = (*Client)(nil)
+	_ ProjectsService          = (*Client)(nil)
+	_ RolesService             = (*Client)(nil)
+	_ SourceControlService     = (*Client)(nil)
+	_ InstanceService          = (*Client)(nil)
+	_ OwnerService             = (*Client)(nil)
+	_ LicenseService           = (*Client)(nil)
+	_ SAMLService              = (*Client)(nil)
+	_ LogStreamingService      = (*Client)(nil)
+	_ CommunityPackagesService = (*Client)(nil)
+	_ AuditService             = (*Client)(nil)
+	_ InsightsService          = (*Client)(nil)
+	_ APIKeysService           = (*Client)(nil)
+	_ Endpoints                = (*Client)(nil)
+)
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SourceControlPreferences represents the Git source control configuration of an instance
+type SourceControlPreferences struct {
+	RepositoryURL    string `json:"repositoryUrl,omitempty"`
+	BranchName       string `json:"branchName,omitempty"`
+	BranchColor      string `json:"branchColor,omitempty"`
+	KeyGeneratorType string `json:"keyGeneratorType,omitempty"`
+	PublicKey        string `json:"publicKey,omitempty"`
+	BranchReadOnly   bool   `json:"branchReadOnly"`
+	Connected        bool   `json:"connected"`
+}
+
+// GetSourceControlPreferences retrieves the source control configuration
+func (c *Client) GetSourceControlPreferences() (*SourceControlPreferences, error) {
+	respBody, err := c.doRequest("GET", "/api/v1/source-control/preferences", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SourceControlPreferences
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// SetSourceControlPreferences updates the source control configuration. Setting
+// a branch name connects the instance to the repository.
+func (c *Client) SetSourceControlPreferences(preferences *SourceControlPreferences) (*SourceControlPreferences, error) {
+	// connected and publicKey are managed by n8n
+	payload := map[string]interface{}{
+		"repositoryUrl":  preferences.RepositoryURL,
+		"branchReadOnly": preferences.BranchReadOnly,
+	}
+	if preferences.BranchName != "" {
+		payload["branchName"] = preferences.BranchName
+	}
+	if preferences.BranchColor != "" {
+		payload["branchColor"] = preferences.BranchColor
+	}
+	if preferences.KeyGeneratorType != "" {
+		payload["keyGeneratorType"] = preferences.KeyGeneratorType
+	}
+
+	respBody, err := c.doRequest("POST", "/api/v1/source-control/preferences", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SourceControlPreferences
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GenerateSourceControlKeyPair replaces the SSH key pair used to access the repository
+func (c *Client) GenerateSourceControlKeyPair(keyGeneratorType string) (*SourceControlPreferences, error) {
+	payload := map[string]string{}
+	if keyGeneratorType != "" {
+		payload["keyGeneratorType"] = keyGeneratorType
+	}
+
+	respBody, err := c.doRequest("POST", "/api/v1/source-control/generate-key-pair", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SourceControlPreferences
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DisconnectSourceControl disconnects the instance from its repository
+func (c *Client) DisconnectSourceControl(keepKeyPair bool) error {
+	payload := map[string]bool{
+		"keepKeyPair": keepKeyPair,
+	}
+
+	_, err := c.doRequest("POST", "/api/v1/source-control/disconnect", payload)
+	return err
+}
+
+// SourceControlPullResult represents the resources imported by a source control pull
+type SourceControlPullResult struct {
+	Workflows   []SourceControlPulledResource `json:"workflows"`
+	Credentials []SourceControlPulledResource `json:"credentials"`
+}
+
+// SourceControlPulledResource represents a workflow or credential imported by a pull
+type SourceControlPulledResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+// PullSourceControl pulls the connected branch into the instance. With force,
+// local changes are overwritten instead of aborting the pull.
+func (c *Client) PullSourceControl(force bool) (*SourceControlPullResult, error) {
+	payload := map[string]bool{
+		"force": force,
+	}
+
+	respBody, err := c.doRequest("POST", "/api/v1/source-control/pull", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SourceControlPullResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Tag represents an n8n tag
+type Tag struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// TagListResponse represents the response from listing tags
+type TagListResponse struct {
+	Data       []Tag  `json:"data"`
+	NextCursor string `json:"nextCursor"`
+}
+
+// ListTags lists all tags
+func (c *Client) ListTags() ([]Tag, error) {
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+
+	var tags []Tag
+	for {
+		respBody, err := c.doRequest("GET", "/api/v1/tags?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result TagListResponse
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		tags = append(tags, result.Data...)
+		if result.NextCursor == "" {
+			return tags, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
+
+// CreateTag creates a new tag
+func (c *Client) CreateTag(name string) (*Tag, error) {
+	payload := map[string]string{
+		"name": name,
+	}
+
+	respBody, err := c.doRequest("POST", "/api/v1/tags", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Tag
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
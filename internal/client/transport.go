@@ -0,0 +1,102 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// transport returns the transport of the HTTP client, replacing the default
+// transport with a copy that can be configured
+func (c *Client) transport() *http.Transport {
+	if t, ok := c.HTTPClient.Transport.(*http.Transport); ok {
+		return t
+	}
+
+	t := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		t = defaultTransport.Clone()
+	}
+	c.HTTPClient.Transport = t
+	return t
+}
+
+// tlsConfig returns the TLS configuration of the transport, creating it if
+// needed
+func (c *Client) tlsConfig() *tls.Config {
+	t := c.transport()
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return t.TLSClientConfig
+}
+
+// SetCACertificates trusts the PEM encoded CA certificates for the
+// connection to n8n, in addition to the CAs of the system
+func (c *Client) SetCACertificates(pem []byte) error {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no PEM encoded certificates found")
+	}
+
+	c.tlsConfig().RootCAs = pool
+	return nil
+}
+
+// SetInsecureSkipVerify disables the verification of the certificate of n8n
+func (c *Client) SetInsecureSkipVerify(skip bool) {
+	c.tlsConfig().InsecureSkipVerify = skip
+}
+
+// SetClientCertificate presents the PEM encoded certificate and key to n8n,
+// or to a gateway in front of it that requires mutual TLS
+func (c *Client) SetClientCertificate(certPEM, keyPEM []byte) error {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+
+	c.tlsConfig().Certificates = []tls.Certificate{cert}
+	return nil
+}
+
+// SetProxy sends all requests through the HTTP, HTTPS or SOCKS5 proxy at
+// proxyURL. Without it, the proxy is taken from the HTTPS_PROXY, HTTP_PROXY
+// and NO_PROXY environment variables.
+func (c *Client) SetProxy(proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return fmt.Errorf("unsupported proxy scheme %q: use http, https, socks5 or socks5h", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("proxy URL %q has no host", proxyURL)
+	}
+
+	c.transport().Proxy = http.ProxyURL(u)
+	return nil
+}
+
+// SetIdleConnections sets how many idle connections to n8n are kept open for
+// reuse, and for how long. As all requests go to the same host, the limit
+// applies per host as well.
+func (c *Client) SetIdleConnections(maxIdle int, idleTimeout time.Duration) {
+	t := c.transport()
+	if maxIdle > 0 {
+		t.MaxIdleConns = maxIdle
+		t.MaxIdleConnsPerHost = maxIdle
+	}
+	if idleTimeout > 0 {
+		t.IdleConnTimeout = idleTimeout
+	}
+}
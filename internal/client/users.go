@@ -0,0 +1,223 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// User represents an n8n user
+type User struct {
+	ID              string `json:"id,omitempty"`
+	Email           string `json:"email"`
+	FirstName       string `json:"firstName,omitempty"`
+	LastName        string `json:"lastName,omitempty"`
+	Role            string `json:"role,omitempty"`
+	GlobalRole      string `json:"globalRole,omitempty"` // Some n8n versions use globalRole instead of role
+	CreatedAt       string `json:"createdAt,omitempty"`
+	UpdatedAt       string `json:"updatedAt,omitempty"`
+	InviteAcceptURL string `json:"inviteAcceptUrl,omitempty"` // Only populated on user creation
+	IsOwner         bool   `json:"isOwner,omitempty"`
+	IsPending       bool   `json:"isPending,omitempty"`
+}
+
+// GetRole returns the role, preferring GlobalRole if Role is empty
+func (u *User) GetRole() string {
+	if u.Role != "" {
+		return u.Role
+	}
+	return u.GlobalRole
+}
+
+// SetRole sets both Role and GlobalRole to ensure compatibility
+func (u *User) SetRole(role string) {
+	u.Role = role
+	u.GlobalRole = role
+}
+
+// UserListResponse represents the response from listing users
+type UserListResponse struct {
+	Data       []User `json:"data"`
+	NextCursor string `json:"nextCursor"`
+}
+
+// CreateUserResponse represents the response from creating users
+type CreateUserResponse struct {
+	Error string `json:"error"`
+	User  struct {
+		ID              string `json:"id"`
+		Email           string `json:"email"`
+		InviteAcceptURL string `json:"inviteAcceptUrl,omitempty"`
+		Role            string `json:"role,omitempty"`
+		EmailSent       bool   `json:"emailSent,omitempty"`
+	} `json:"user"`
+}
+
+// UserInvitation represents a single entry of a bulk user invitation
+type UserInvitation struct {
+	Email string `json:"email"`
+	Role  string `json:"role,omitempty"`
+}
+
+// InviteUsers invites several users with a single request. The results are
+// returned in the order of the invitations; per-user failures are reported in
+// the Error field of the corresponding result rather than as an error.
+func (c *Client) InviteUsers(invitations []UserInvitation) ([]CreateUserResponse, error) {
+	respBody, err := c.doRequest("POST", "/api/v1/users", invitations)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is an array of objects with "user" and "error" fields
+	var results []CreateUserResponse
+	if err := json.Unmarshal(respBody, &results); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if len(results) != len(invitations) {
+		return nil, fmt.Errorf("expected %d users in API response, got %d", len(invitations), len(results))
+	}
+
+	return results, nil
+}
+
+// CreateUser creates a new user
+func (c *Client) CreateUser(user *User) (*User, error) {
+	// n8n API expects an array of users for bulk creation
+	// The request should only include email and role
+	results, err := c.InviteUsers([]UserInvitation{{Email: user.Email, Role: user.Role}})
+	if err != nil {
+		return nil, err
+	}
+
+	if results[0].Error != "" {
+		return nil, fmt.Errorf("API error: %s", results[0].Error)
+	}
+
+	// Preserve the inviteAcceptUrl from the creation response
+	inviteAcceptURL := results[0].User.InviteAcceptURL
+
+	// Fetch the full user details to get all fields including role, timestamps, etc.
+	// The create response doesn't include all fields we need
+	createdUser, err := c.GetUser(results[0].User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the API doesn't return the role in GetUser response, preserve the role from the request
+	// This handles cases where n8n API doesn't return role/globalRole in the GET response
+	if createdUser.GetRole() == "" && user.Role != "" {
+		createdUser.SetRole(user.Role)
+	}
+
+	// Set the inviteAcceptUrl from the creation response (not available in GET response)
+	createdUser.InviteAcceptURL = inviteAcceptURL
+
+	return createdUser, nil
+}
+
+// ResendInvitation invites a pending user again and returns the new
+// invitation URL. n8n issues a new invitation when an email address that is
+// still pending is invited again.
+func (c *Client) ResendInvitation(email, role string) (string, error) {
+	results, err := c.InviteUsers([]UserInvitation{{Email: email, Role: role}})
+	if err != nil {
+		return "", err
+	}
+
+	if results[0].Error != "" {
+		return "", fmt.Errorf("API error: %s", results[0].Error)
+	}
+
+	return results[0].User.InviteAcceptURL, nil
+}
+
+// GetUser retrieves a user by ID
+func (c *Client) GetUser(id string) (*User, error) {
+	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/users/%s?includeRole=true", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result User
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateUser updates an existing user's role
+// Note: According to n8n API docs, only the role can be updated via PATCH /users/{id}/role
+func (c *Client) UpdateUser(id string, user *User) (*User, error) {
+	// Update the role if it's provided
+	if user.Role != "" {
+		type UpdateRoleRequest struct {
+			NewRoleName string `json:"newRoleName"`
+		}
+
+		request := UpdateRoleRequest{
+			NewRoleName: user.Role,
+		}
+
+		_, err := c.doRequest("PATCH", fmt.Sprintf("/api/v1/users/%s/role", id), request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// After updating, fetch the user to get the current state
+	updatedUser, err := c.GetUser(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the API doesn't return the role in GetUser response, preserve the role from the request
+	// This handles cases where n8n API doesn't return role/globalRole in the GET response
+	if updatedUser.GetRole() == "" && user.Role != "" {
+		updatedUser.SetRole(user.Role)
+	}
+
+	return updatedUser, nil
+}
+
+// DeleteUser deletes a user
+func (c *Client) DeleteUser(id string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/users/%s", id), nil)
+	return err
+}
+
+// DeleteUserWithTransfer deletes a user and moves their workflows and
+// credentials to a project. The public API cannot transfer them, so the
+// internal REST API is used, which not every instance accepts API keys for.
+func (c *Client) DeleteUserWithTransfer(id, projectID string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/rest/users/%s?transferId=%s", id, url.QueryEscape(projectID)), nil)
+	return err
+}
+
+// ListUsers lists all users
+func (c *Client) ListUsers() ([]User, error) {
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+	// Roles are only included when asked for
+	query.Set("includeRole", "true")
+
+	var users []User
+	for {
+		respBody, err := c.doRequest("GET", "/api/v1/users?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result UserListResponse
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		users = append(users, result.Data...)
+		if result.NextCursor == "" {
+			return users, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
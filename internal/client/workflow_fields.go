@@ -0,0 +1,155 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client/api"
+)
+
+// Optional fields of workflow requests that only some n8n versions accept in
+// the public API. Older versions reject requests that contain them.
+const (
+	workflowFieldProjectID      = "projectId"
+	workflowFieldParentFolderID = "parentFolderId"
+	workflowFieldPinData        = "pinData"
+)
+
+// workflowFields remembers the optional fields of workflow requests that the
+// instance rejected, so that later requests are checked before they are
+// sent. It is shared by the copies WithContext makes.
+type workflowFields struct {
+	mu       sync.Mutex
+	rejected map[string]bool
+}
+
+// supported reports whether the instance did not reject field so far
+func (f *workflowFields) supported(field string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return !f.rejected[field]
+}
+
+// reject records that the instance does not accept field
+func (f *workflowFields) reject(field string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.rejected == nil {
+		f.rejected = make(map[string]bool)
+	}
+	f.rejected[field] = true
+}
+
+// UnsupportedFieldError is returned for a workflow that needs a field the
+// public API of the instance does not accept
+type UnsupportedFieldError struct {
+	Field string
+	// Err is the error of the request n8n rejected, if the field was sent
+	Err error
+}
+
+// Error implements the error interface
+func (e *UnsupportedFieldError) Error() string {
+	message := fmt.Sprintf("the public API of this n8n version does not accept %s in workflow requests; upgrade n8n to set it through the API", e.Field)
+	if e.Err != nil {
+		message += ": " + e.Err.Error()
+	}
+	return message
+}
+
+// Unwrap returns the error of the rejected request
+func (e *UnsupportedFieldError) Unwrap() error {
+	return e.Err
+}
+
+// sentWorkflowFields returns the optional fields a workflow request contains
+func sentWorkflowFields(request *api.WorkflowRequest) []string {
+	var fields []string
+	if request.ProjectId != nil {
+		fields = append(fields, workflowFieldProjectID)
+	}
+	if request.ParentFolderId != nil {
+		fields = append(fields, workflowFieldParentFolderID)
+	}
+	if request.PinData != nil {
+		fields = append(fields, workflowFieldPinData)
+	}
+	return fields
+}
+
+// omitWorkflowField removes an optional field from a workflow request
+func omitWorkflowField(request *api.WorkflowRequest, field string) {
+	switch field {
+	case workflowFieldProjectID:
+		request.ProjectId = nil
+	case workflowFieldParentFolderID:
+		request.ParentFolderId = nil
+	case workflowFieldPinData:
+		request.PinData = nil
+	}
+}
+
+// rejectedWorkflowFields returns the optional fields of a request that n8n
+// rejected as unknown properties. When the error does not name them, all
+// optional fields that were sent are assumed to be rejected.
+func rejectedWorkflowFields(err error, sent []string) []string {
+	var apiErr *APIError
+	if len(sent) == 0 || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		return nil
+	}
+	if !strings.Contains(strings.ToLower(apiErr.Body), "additional propert") {
+		return nil
+	}
+
+	var named []string
+	for _, field := range sent {
+		if strings.Contains(apiErr.Body, field) {
+			named = append(named, field)
+		}
+	}
+	if len(named) == 0 {
+		return sent
+	}
+	return named
+}
+
+// sendWorkflowRequest sends a request that creates or replaces a workflow.
+// The project of a new workflow is left out for instances that do not
+// accept it, which then create the workflow in the personal project of the
+// API key owner; callers transfer it afterwards. Pinned data and folders have
+// no such fallback and fail with an UnsupportedFieldError, without sending
+// the request once the instance rejected them.
+func (c *Client) sendWorkflowRequest(method, path string, request *api.WorkflowRequest) ([]byte, error) {
+	for _, field := range sentWorkflowFields(request) {
+		switch {
+		case c.workflowFields.supported(field):
+		case field == workflowFieldProjectID:
+			omitWorkflowField(request, field)
+		default:
+			return nil, &UnsupportedFieldError{Field: field}
+		}
+	}
+
+	for {
+		respBody, err := c.doRequest(method, path, request)
+		rejected := rejectedWorkflowFields(err, sentWorkflowFields(request))
+		if len(rejected) == 0 {
+			return respBody, err
+		}
+
+		for _, field := range rejected {
+			c.workflowFields.reject(field)
+		}
+		for _, field := range rejected {
+			if field != workflowFieldProjectID {
+				return nil, &UnsupportedFieldError{Field: field, Err: err}
+			}
+		}
+
+		// Send it again without the project
+		omitWorkflowField(request, workflowFieldProjectID)
+	}
+}
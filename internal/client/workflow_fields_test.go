@@ -0,0 +1,129 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestRejectedWorkflowFields(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		sent []string
+		want []string
+	}{
+		"named field": {
+			err:  &APIError{StatusCode: http.StatusBadRequest, Body: `{"message":"request/body must NOT have additional properties 'pinData'"}`},
+			sent: []string{workflowFieldProjectID, workflowFieldPinData},
+			want: []string{workflowFieldPinData},
+		},
+		"unnamed fields": {
+			err:  &APIError{StatusCode: http.StatusBadRequest, Body: `{"message":"request/body must NOT have additional properties"}`},
+			sent: []string{workflowFieldProjectID},
+			want: []string{workflowFieldProjectID},
+		},
+		"other validation error": {
+			err:  &APIError{StatusCode: http.StatusBadRequest, Body: `{"message":"request/body/name must be string"}`},
+			sent: []string{workflowFieldProjectID},
+		},
+		"not a bad request": {
+			err:  &APIError{StatusCode: http.StatusInternalServerError, Body: "additional properties"},
+			sent: []string{workflowFieldProjectID},
+		},
+		"nothing optional sent": {
+			err: &APIError{StatusCode: http.StatusBadRequest, Body: "additional properties"},
+		},
+		"no error": {
+			sent: []string{workflowFieldProjectID},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := rejectedWorkflowFields(tt.err, tt.sent)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rejectedWorkflowFields() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// rejectingServer answers workflow requests like an n8n version that does
+// not accept the given field, and records the bodies it received.
+func rejectingServer(t *testing.T, field string, bodies *[]map[string]interface{}) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		var body map[string]interface{}
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Error(err)
+		}
+		*bodies = append(*bodies, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, ok := body[field]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err = w.Write([]byte(`{"message":"request/body must NOT have additional properties '` + field + `'"}`))
+		} else {
+			_, err = w.Write([]byte(`{"id":"1","name":"Orders","nodes":[],"connections":{}}`))
+		}
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCreateWorkflowWithoutProjectSupport(t *testing.T) {
+	var bodies []map[string]interface{}
+	c := NewClient(rejectingServer(t, workflowFieldProjectID, &bodies).URL, "key")
+	workflow := &Workflow{Name: "Orders", Nodes: []interface{}{}, Connections: map[string]interface{}{}, ProjectID: "p1"}
+
+	for range 2 {
+		if _, err := c.CreateWorkflow(workflow); err != nil {
+			t.Fatalf("CreateWorkflow() error = %v", err)
+		}
+	}
+
+	// The first workflow is sent twice, the second one without the project
+	if len(bodies) != 3 {
+		t.Fatalf("got %d requests, want 3", len(bodies))
+	}
+	for i, body := range bodies {
+		if _, ok := body[workflowFieldProjectID]; ok != (i == 0) {
+			t.Errorf("request %d: projectId sent = %v", i, ok)
+		}
+	}
+}
+
+func TestUpdateWorkflowWithoutPinDataSupport(t *testing.T) {
+	var bodies []map[string]interface{}
+	c := NewClient(rejectingServer(t, workflowFieldPinData, &bodies).URL, "key")
+	workflow := &Workflow{
+		Name:        "Orders",
+		Nodes:       []interface{}{},
+		Connections: map[string]interface{}{},
+		PinData:     map[string]interface{}{"Start": []interface{}{}},
+	}
+
+	for range 2 {
+		_, err := c.UpdateWorkflow("1", workflow)
+		var unsupported *UnsupportedFieldError
+		if !errors.As(err, &unsupported) || unsupported.Field != workflowFieldPinData {
+			t.Fatalf("UpdateWorkflow() error = %v, want an UnsupportedFieldError for pinData", err)
+		}
+	}
+
+	// The second update fails without a request
+	if len(bodies) != 1 {
+		t.Errorf("got %d requests, want 1", len(bodies))
+	}
+}
@@ -0,0 +1,639 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client/api"
+)
+
+// workflowListSnapshot is the workflow list RefreshWorkflow answers from
+type workflowListSnapshot struct {
+	mu    sync.Mutex
+	cache map[string]*Workflow
+	// withPinData is false when the list was requested without pinned data
+	withPinData bool
+}
+
+// Workflow represents an n8n workflow
+type Workflow struct {
+	Connections map[string]interface{} `json:"connections"`
+	Settings    map[string]interface{} `json:"settings,omitempty"`
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+	CreatedAt   string                 `json:"createdAt,omitempty"`
+	UpdatedAt   string                 `json:"updatedAt,omitempty"`
+	VersionID   string                 `json:"versionId,omitempty"`
+	Nodes       []interface{}          `json:"nodes"`
+	Tags        []map[string]string    `json:"tags,omitempty"`
+	Shared      []SharedResource       `json:"shared,omitempty"`
+	// ParentFolderID is the folder the workflow is in; empty at the top level
+	// of its project
+	ParentFolderID string `json:"parentFolderId,omitempty"`
+	Active         bool   `json:"active"`
+	// IsArchived is set for workflows that were archived instead of deleted
+	IsArchived bool `json:"isArchived,omitempty"`
+	// ProjectID is the project a new workflow is created in; empty for the
+	// personal project of the API key owner
+	ProjectID string `json:"projectId,omitempty"`
+	// PinData is the output pinned to nodes in the editor, keyed by node name
+	PinData map[string]interface{} `json:"pinData,omitempty"`
+	// StaticData is kept by trigger nodes between executions (e.g. the last
+	// poll time); it is never sent back to n8n
+	StaticData interface{} `json:"staticData,omitempty"`
+}
+
+// SharedResource represents the relation between a workflow or credential and
+// a project it belongs to or is shared with
+type SharedResource struct {
+	Role      string `json:"role"`
+	ProjectID string `json:"projectId"`
+	// Project is only included by n8n versions that expand the relation
+	Project *Project `json:"project,omitempty"`
+}
+
+// OwnerProjectID returns the ID of the project that owns the workflow, or an
+// empty string if the API did not include sharing details
+func (w *Workflow) OwnerProjectID() string {
+	for _, shared := range w.Shared {
+		if shared.Role == "workflow:owner" {
+			return shared.ProjectID
+		}
+	}
+	return ""
+}
+
+// clone returns a deep copy of the workflow, so that callers can change the
+// copy without affecting a cached workflow
+func (w *Workflow) clone() *Workflow {
+	copied := *w
+	copied.Connections, _ = cloneJSONValue(w.Connections).(map[string]interface{})
+	copied.Settings, _ = cloneJSONValue(w.Settings).(map[string]interface{})
+	copied.Nodes, _ = cloneJSONValue(w.Nodes).([]interface{})
+	copied.PinData, _ = cloneJSONValue(w.PinData).(map[string]interface{})
+	copied.StaticData = cloneJSONValue(w.StaticData)
+
+	if w.Tags != nil {
+		copied.Tags = make([]map[string]string, len(w.Tags))
+		for i, tag := range w.Tags {
+			copied.Tags[i] = maps.Clone(tag)
+		}
+	}
+	if w.Shared != nil {
+		copied.Shared = make([]SharedResource, len(w.Shared))
+		for i, shared := range w.Shared {
+			if shared.Project != nil {
+				project := *shared.Project
+				shared.Project = &project
+			}
+			copied.Shared[i] = shared
+		}
+	}
+	return &copied
+}
+
+// cloneJSONValue deep copies a value decoded from JSON
+func cloneJSONValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if value == nil {
+			return value
+		}
+		copied := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			copied[key] = cloneJSONValue(item)
+		}
+		return copied
+	case []interface{}:
+		if value == nil {
+			return value
+		}
+		copied := make([]interface{}, len(value))
+		for i, item := range value {
+			copied[i] = cloneJSONValue(item)
+		}
+		return copied
+	}
+	return value
+}
+
+// WorkflowListResponse represents the response from listing workflows
+type WorkflowListResponse struct {
+	Data       []Workflow `json:"data"`
+	NextCursor string     `json:"nextCursor"`
+}
+
+// WorkflowFilter narrows down the workflows returned by SearchWorkflows.
+// Empty fields are not filtered on.
+type WorkflowFilter struct {
+	Active    *bool
+	Name      string
+	ProjectID string
+	// Tags only returns workflows that have all of the given tag names
+	Tags []string
+}
+
+// CreateWorkflow creates a new workflow. Instances that do not accept a
+// project on creation put it in the personal project of the API key owner, so
+// callers compare the owner project of the result and transfer it.
+func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
+	// Store the desired tags (read-only on creation)
+	// Note: active is changed with ActivateWorkflow and DeactivateWorkflow
+	desiredTags := workflow.Tags
+
+	// Create workflow without tags field (it's read-only on creation)
+	createPayload, err := workflowRequest(workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	if workflow.ProjectID != "" {
+		createPayload.ProjectId = &workflow.ProjectID
+	}
+
+	respBody, err := c.sendWorkflowRequest("POST", "/api/v1/workflows", createPayload)
+	if err != nil {
+		return nil, workflowError(err, workflow.Nodes)
+	}
+
+	var result Workflow
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// If tags are specified, update them after creation
+	// Only update if tags have actual content (not just empty array from n8n export)
+	if len(desiredTags) > 0 {
+		// Check if tags have valid IDs
+		hasValidTags := false
+		for _, tag := range desiredTags {
+			if id, ok := tag["id"]; ok && id != "" {
+				hasValidTags = true
+				break
+			}
+		}
+
+		if hasValidTags {
+			if err := c.UpdateWorkflowTags(result.ID, desiredTags); err != nil {
+				// If tags update fails, delete the workflow to clean up
+				deleteErr := c.DeleteWorkflow(result.ID)
+				if deleteErr != nil {
+					return nil, fmt.Errorf("failed to update workflow tags: %w (also failed to clean up workflow: %v) - hint: tags must exist in n8n before assigning them to workflows", err, deleteErr)
+				}
+				return nil, fmt.Errorf("failed to update workflow tags, workflow rolled back: %w (hint: tags must exist in n8n before assigning them to workflows)", err)
+			}
+			result.Tags = desiredTags
+		}
+	}
+
+	return &result, nil
+}
+
+// workflowRequest builds the body of the requests that create or replace a
+// workflow. Fields of nodes and settings that the models do not know are
+// kept, so that they reach n8n unchanged.
+func workflowRequest(workflow *Workflow) (*api.WorkflowRequest, error) {
+	request := &api.WorkflowRequest{
+		Name:        workflow.Name,
+		Connections: workflow.Connections,
+	}
+
+	if err := convertJSON(workflow.Nodes, &request.Nodes); err != nil {
+		return nil, fmt.Errorf("invalid workflow nodes: %w", err)
+	}
+
+	if workflow.Settings != nil {
+		request.Settings = &api.WorkflowSettings{}
+		if err := convertJSON(workflow.Settings, request.Settings); err != nil {
+			return nil, fmt.Errorf("invalid workflow settings: %w", err)
+		}
+	}
+
+	if workflow.PinData != nil {
+		request.PinData = &workflow.PinData
+	}
+
+	return request, nil
+}
+
+// convertJSON copies a decoded JSON value into a model
+func convertJSON(value, model interface{}) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, model)
+}
+
+// GetWorkflow retrieves a workflow by ID
+func (c *Client) GetWorkflow(id string) (*Workflow, error) {
+	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/workflows/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Workflow
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetWorkflowExcludingPinnedData retrieves a workflow by ID without the
+// output pinned to its nodes, which can be large
+func (c *Client) GetWorkflowExcludingPinnedData(id string) (*Workflow, error) {
+	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/workflows/%s?excludePinnedData=true", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Workflow
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RefreshWorkflow retrieves a workflow for a state refresh. When
+// WorkflowListRefresh is enabled, the first call lists all workflows once and
+// later calls are answered from that snapshot; workflows missing from the
+// snapshot fall back to GetWorkflow.
+func (c *Client) RefreshWorkflow(id string) (*Workflow, error) {
+	return c.refreshWorkflow(id, true)
+}
+
+// RefreshWorkflowExcludingPinnedData retrieves a workflow for a state
+// refresh that does not need the output pinned to its nodes. It shares the
+// read cache with RefreshWorkflow, so that the resources of one workflow
+// refreshed in the same run read it only once.
+func (c *Client) RefreshWorkflowExcludingPinnedData(id string) (*Workflow, error) {
+	return c.refreshWorkflow(id, false)
+}
+
+// refreshWorkflow answers from the list snapshot when WorkflowListRefresh is
+// enabled and the snapshot has the pinned data the caller needs, and reads
+// the workflow otherwise.
+func (c *Client) refreshWorkflow(id string, withPinData bool) (*Workflow, error) {
+	if !c.WorkflowListRefresh {
+		return c.readWorkflow(id, withPinData)
+	}
+
+	c.workflowList.mu.Lock()
+	if c.workflowList.cache == nil {
+		workflows, err := c.ListWorkflows()
+		if err != nil {
+			c.workflowList.mu.Unlock()
+			return nil, err
+		}
+
+		c.workflowList.cache = make(map[string]*Workflow, len(workflows))
+		for i := range workflows {
+			c.workflowList.cache[workflows[i].ID] = &workflows[i]
+		}
+		c.workflowList.withPinData = !c.ExcludePinnedData
+	}
+	workflow, ok := c.workflowList.cache[id]
+	if withPinData && !c.workflowList.withPinData {
+		ok = false
+	}
+	c.workflowList.mu.Unlock()
+
+	if !ok {
+		return c.readWorkflow(id, withPinData)
+	}
+
+	// The snapshot is shared by all resources refreshed from it
+	return workflow.clone(), nil
+}
+
+// readWorkflow answers from the read cache, or gets the workflow and caches
+// it. Workflows read without pinned data only answer callers that do not
+// need it.
+func (c *Client) readWorkflow(id string, withPinData bool) (*Workflow, error) {
+	if workflow := c.workflowReads.get(id, withPinData, c.ReadCacheTTL); workflow != nil {
+		return workflow, nil
+	}
+
+	var workflow *Workflow
+	var err error
+	if withPinData {
+		workflow, err = c.GetWorkflow(id)
+	} else {
+		workflow, err = c.GetWorkflowExcludingPinnedData(id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.workflowReads.put(id, workflow, withPinData)
+	return workflow, nil
+}
+
+// workflowReadCache holds recently read workflows. It is shared by the
+// copies WithContext makes.
+type workflowReadCache struct {
+	mu      sync.Mutex
+	entries map[string]workflowReadEntry
+}
+
+// workflowReadEntry is a cached workflow
+type workflowReadEntry struct {
+	workflow    *Workflow
+	withPinData bool
+	readAt      time.Time
+}
+
+// get returns a deep copy of a cached workflow that was read less than ttl ago,
+// or nil
+func (rc *workflowReadCache) get(id string, withPinData bool, ttl time.Duration) *Workflow {
+	if ttl <= 0 {
+		return nil
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[id]
+	if !ok || time.Since(entry.readAt) > ttl || (withPinData && !entry.withPinData) {
+		return nil
+	}
+
+	return entry.workflow.clone()
+}
+
+// put caches a deep copy of a workflow that was just read
+func (rc *workflowReadCache) put(id string, workflow *Workflow, withPinData bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if rc.entries == nil {
+		rc.entries = make(map[string]workflowReadEntry)
+	}
+	rc.entries[id] = workflowReadEntry{workflow: workflow.clone(), withPinData: withPinData, readAt: time.Now()}
+}
+
+// forgetCachedWorkflow drops a workflow from the list snapshot and the read
+// cache so a later refresh does not return data that predates a write.
+func (c *Client) forgetCachedWorkflow(id string) {
+	c.workflowList.mu.Lock()
+	delete(c.workflowList.cache, id)
+	c.workflowList.mu.Unlock()
+
+	c.workflowReads.mu.Lock()
+	delete(c.workflowReads.entries, id)
+	c.workflowReads.mu.Unlock()
+}
+
+// UpdateWorkflow updates an existing workflow
+func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error) {
+	c.forgetCachedWorkflow(id)
+
+	// Store the desired tags (read-only)
+	// Note: active is changed with ActivateWorkflow and DeactivateWorkflow
+	desiredTags := workflow.Tags
+
+	// Update workflow without tags field (it's read-only)
+	updatePayload, err := workflowRequest(workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	if workflow.ParentFolderID != "" {
+		updatePayload.ParentFolderId = &workflow.ParentFolderID
+	}
+
+	respBody, err := c.sendWorkflowRequest("PUT", fmt.Sprintf("/api/v1/workflows/%s", id), updatePayload)
+	if err != nil {
+		return nil, workflowError(err, workflow.Nodes)
+	}
+
+	var result Workflow
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Update tags if they changed
+	if len(desiredTags) > 0 {
+		// Check if tags have valid IDs
+		hasValidTags := false
+		for _, tag := range desiredTags {
+			if id, ok := tag["id"]; ok && id != "" {
+				hasValidTags = true
+				break
+			}
+		}
+
+		if hasValidTags {
+			if err := c.UpdateWorkflowTags(id, desiredTags); err != nil {
+				return nil, fmt.Errorf("failed to update workflow tags: %w (hint: tags must exist in n8n before assigning them to workflows)", err)
+			}
+			result.Tags = desiredTags
+		}
+	}
+
+	return &result, nil
+}
+
+// DeleteWorkflow deletes a workflow
+func (c *Client) DeleteWorkflow(id string) error {
+	c.forgetCachedWorkflow(id)
+
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/workflows/%s", id), nil)
+	return err
+}
+
+// ArchiveWorkflow archives a workflow. Archived workflows are inactive and
+// hidden in the editor until they are restored or deleted.
+func (c *Client) ArchiveWorkflow(id string) error {
+	c.forgetCachedWorkflow(id)
+
+	_, err := c.doRequest("POST", fmt.Sprintf("/api/v1/workflows/%s/archive", id), nil)
+	return err
+}
+
+// ActivateWorkflow activates a workflow. When n8n refuses, the error carries
+// the reason it gave (e.g. a missing trigger node or a webhook path conflict)
+// instead of the raw response body.
+func (c *Client) ActivateWorkflow(id string) (*Workflow, error) {
+	c.forgetCachedWorkflow(id)
+
+	respBody, err := c.doRequest("POST", fmt.Sprintf("/api/v1/workflows/%s/activate", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Workflow
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeactivateWorkflow deactivates a workflow
+func (c *Client) DeactivateWorkflow(id string) (*Workflow, error) {
+	c.forgetCachedWorkflow(id)
+
+	respBody, err := c.doRequest("POST", fmt.Sprintf("/api/v1/workflows/%s/deactivate", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Workflow
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// TransferWorkflow moves a workflow to another project
+func (c *Client) TransferWorkflow(id, projectID string) error {
+	c.forgetCachedWorkflow(id)
+
+	payload := map[string]string{
+		"destinationProjectId": projectID,
+	}
+
+	_, err := c.doRequest("PUT", fmt.Sprintf("/api/v1/workflows/%s/transfer", id), payload)
+	return err
+}
+
+// UpdateWorkflowTags updates the tags of a workflow
+func (c *Client) UpdateWorkflowTags(id string, tags []map[string]string) error {
+	c.forgetCachedWorkflow(id)
+
+	// Convert tags to the format expected by the API
+	tagPayload := make([]map[string]string, len(tags))
+	for i, tag := range tags {
+		tagPayload[i] = map[string]string{
+			"id": tag["id"],
+		}
+	}
+
+	_, err := c.doRequest("PUT", fmt.Sprintf("/api/v1/workflows/%s/tags", id), tagPayload)
+	return err
+}
+
+// GetWorkflowTags retrieves the tags of a workflow
+func (c *Client) GetWorkflowTags(id string) ([]Tag, error) {
+	respBody, err := c.doRequest("GET", fmt.Sprintf("/api/v1/workflows/%s/tags", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Tag
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
+
+// SetWorkflowTags replaces the tags of a workflow with the given tag IDs
+func (c *Client) SetWorkflowTags(id string, tagIDs []string) ([]Tag, error) {
+	c.forgetCachedWorkflow(id)
+
+	tagPayload := make([]map[string]string, len(tagIDs))
+	for i, tagID := range tagIDs {
+		tagPayload[i] = map[string]string{
+			"id": tagID,
+		}
+	}
+
+	respBody, err := c.doRequest("PUT", fmt.Sprintf("/api/v1/workflows/%s/tags", id), tagPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Tag
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
+
+// PinnedDataExcluded reports whether workflow lists and refreshes leave out
+// pinned data
+func (c *Client) PinnedDataExcluded() bool {
+	return c.ExcludePinnedData
+}
+
+// ListWorkflows lists all workflows. Their pinned data is left out when
+// ExcludePinnedData is set.
+func (c *Client) ListWorkflows() ([]Workflow, error) {
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+	if c.ExcludePinnedData {
+		query.Set("excludePinnedData", "true")
+	}
+
+	var workflows []Workflow
+	for {
+		respBody, err := c.doRequest("GET", "/api/v1/workflows?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result WorkflowListResponse
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		workflows = append(workflows, result.Data...)
+		if result.NextCursor == "" {
+			return workflows, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
+
+// SearchWorkflows lists all workflows matching the filter. Their pinned data
+// is left out when ExcludePinnedData is set.
+func (c *Client) SearchWorkflows(filter WorkflowFilter) ([]Workflow, error) {
+	query := url.Values{}
+	query.Set("limit", c.pageSize())
+	if c.ExcludePinnedData {
+		query.Set("excludePinnedData", "true")
+	}
+	if filter.Active != nil {
+		query.Set("active", strconv.FormatBool(*filter.Active))
+	}
+	if filter.Name != "" {
+		query.Set("name", filter.Name)
+	}
+	if filter.ProjectID != "" {
+		query.Set("projectId", filter.ProjectID)
+	}
+	if len(filter.Tags) > 0 {
+		query.Set("tags", strings.Join(filter.Tags, ","))
+	}
+
+	var workflows []Workflow
+	for {
+		respBody, err := c.doRequest("GET", "/api/v1/workflows?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result WorkflowListResponse
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		workflows = append(workflows, result.Data...)
+		if result.NextCursor == "" {
+			return workflows, nil
+		}
+		query.Set("cursor", result.NextCursor)
+	}
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWorkflowClone(t *testing.T) {
+	workflow := &Workflow{
+		ID:          "1",
+		Name:        "Orders",
+		Nodes:       []interface{}{map[string]interface{}{"name": "Webhook", "position": []interface{}{0.0, 0.0}}},
+		Connections: map[string]interface{}{"Webhook": map[string]interface{}{"main": []interface{}{}}},
+		Settings:    map[string]interface{}{"timezone": "UTC"},
+		PinData:     map[string]interface{}{"Webhook": []interface{}{map[string]interface{}{"json": map[string]interface{}{}}}},
+		Tags:        []map[string]string{{"id": "t1", "name": "prod"}},
+		Shared:      []SharedResource{{Role: "workflow:owner", ProjectID: "p1", Project: &Project{ID: "p1"}}},
+	}
+
+	copied := workflow.clone()
+	if !reflect.DeepEqual(copied, workflow) {
+		t.Fatalf("clone() = %#v, want %#v", copied, workflow)
+	}
+
+	copied.Nodes[0].(map[string]interface{})["position"].([]interface{})[0] = 100.0
+	copied.Connections["Webhook"].(map[string]interface{})["main"] = nil
+	copied.Settings["timezone"] = "Europe/Berlin"
+	copied.PinData["Webhook"] = nil
+	copied.Tags[0]["name"] = "dev"
+	copied.Shared[0].Project.ID = "p2"
+
+	node := workflow.Nodes[0].(map[string]interface{})
+	switch {
+	case node["position"].([]interface{})[0] != 0.0:
+		t.Error("changing the nodes of the clone changed the original")
+	case workflow.Connections["Webhook"].(map[string]interface{})["main"] == nil:
+		t.Error("changing the connections of the clone changed the original")
+	case workflow.Settings["timezone"] != "UTC":
+		t.Error("changing the settings of the clone changed the original")
+	case workflow.PinData["Webhook"] == nil:
+		t.Error("changing the pinned data of the clone changed the original")
+	case workflow.Tags[0]["name"] != "prod":
+		t.Error("changing the tags of the clone changed the original")
+	case workflow.Shared[0].Project.ID != "p1":
+		t.Error("changing the sharing of the clone changed the original")
+	}
+}
+
+func TestRefreshWorkflowReturnsCopies(t *testing.T) {
+	tests := map[string]bool{
+		"read cache":    false,
+		"list snapshot": true,
+	}
+
+	for name, listRefresh := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				body := `{"id":"1","name":"Orders","nodes":[{"name":"Webhook","position":[0,0]}],"connections":{}}`
+				if r.URL.Path == "/api/v1/workflows" {
+					body = `{"data":[` + body + `]}`
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if _, err := w.Write([]byte(body)); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "key")
+			c.WorkflowListRefresh = listRefresh
+
+			first, err := c.RefreshWorkflowExcludingPinnedData("1")
+			if err != nil {
+				t.Fatalf("first refresh: %v", err)
+			}
+			first.Nodes[0].(map[string]interface{})["position"] = []interface{}{100.0, 100.0}
+			first.Name = "changed"
+
+			second, err := c.RefreshWorkflowExcludingPinnedData("1")
+			if err != nil {
+				t.Fatalf("second refresh: %v", err)
+			}
+			if requests.Load() != 1 {
+				t.Errorf("got %d requests, want the second refresh to be answered from the cache", requests.Load())
+			}
+			if second.Name != "Orders" {
+				t.Errorf("second refresh returned name %q, want %q", second.Name, "Orders")
+			}
+			if position := second.Nodes[0].(map[string]interface{})["position"]; !reflect.DeepEqual(position, []interface{}{0.0, 0.0}) {
+				t.Errorf("second refresh returned position %v, want [0 0]", position)
+			}
+		})
+	}
+}
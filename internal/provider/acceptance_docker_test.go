@@ -0,0 +1,67 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// n8nStartTimeout is how long a new n8n container may take to become ready
+const n8nStartTimeout = 2 * time.Minute
+
+// n8nPort is the port n8n listens on in its container
+const n8nPort = "5678/tcp"
+
+// startN8N starts an ephemeral n8n container and returns it with the
+// endpoint of n8n once it is ready. Nothing is persisted; the container is
+// gone once it is terminated. N8N_VERSION selects the image tag and
+// N8N_LICENSE_ACTIVATION_KEY, if set, is passed on so that licensed features
+// can be tested.
+func startN8N(ctx context.Context) (testcontainers.Container, string, error) {
+	version := os.Getenv("N8N_VERSION")
+	if version == "" {
+		version = "latest"
+	}
+
+	env := map[string]string{
+		"N8N_DIAGNOSTICS_ENABLED":     "false",
+		"N8N_PERSONALIZATION_ENABLED": "false",
+		"N8N_SECURE_COOKIE":           "false",
+		"N8N_RUNNERS_ENABLED":         "true",
+	}
+	if key := os.Getenv("N8N_LICENSE_ACTIVATION_KEY"); key != "" {
+		env["N8N_LICENSE_ACTIVATION_KEY"] = key
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "docker.n8n.io/n8nio/n8n:" + version,
+			ExposedPorts: []string{n8nPort},
+			Env:          env,
+			WaitingFor: wait.ForHTTP("/healthz/readiness").
+				WithPort(n8nPort).
+				WithStartupTimeout(n8nStartTimeout),
+		},
+		Started: true,
+	})
+	if err != nil {
+		// The container is returned even if it did not become ready
+		if termErr := testcontainers.TerminateContainer(container); termErr != nil {
+			err = fmt.Errorf("%w (removing the container: %v)", err, termErr)
+		}
+		return nil, "", fmt.Errorf("starting n8n %s: %w", version, err)
+	}
+
+	endpoint, err := container.PortEndpoint(ctx, n8nPort, "http")
+	if err != nil {
+		testcontainers.TerminateContainer(container)
+		return nil, "", fmt.Errorf("finding the port of n8n: %w", err)
+	}
+	return container, endpoint, nil
+}
@@ -0,0 +1,209 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/testcontainers/testcontainers-go"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Acceptance tests run the provider through Terraform against a real n8n
+// instance. They are built with the acceptance tag and only run with TF_ACC
+// set, see make testacc. Without N8N_ENDPOINT, TestMain starts an ephemeral
+// n8n container for them, creates its owner and an API key and removes the
+// container when the tests end.
+
+// testAccProtoV6ProviderFactories serves the provider to Terraform from the
+// test binary
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"n8n": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+// runTests starts n8n for the acceptance tests unless N8N_ENDPOINT names an
+// instance to test against, runs the tests and removes it afterwards
+func runTests(m *testing.M) int {
+	if os.Getenv(resource.EnvTfAcc) == "" || os.Getenv("N8N_ENDPOINT") != "" {
+		return m.Run()
+	}
+
+	ctx := context.Background()
+	container, endpoint, err := startN8N(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "setting up acceptance tests: %v\n", err)
+		return 1
+	}
+	defer func() {
+		if err := testcontainers.TerminateContainer(container); err != nil {
+			fmt.Fprintf(os.Stderr, "removing n8n container: %v\n", err)
+		}
+	}()
+
+	apiKey, err := setupAccOwner(endpoint)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "setting up acceptance tests: %v\n", err)
+		return 1
+	}
+	os.Setenv("N8N_ENDPOINT", endpoint)
+	os.Setenv("N8N_API_KEY", apiKey)
+
+	return m.Run()
+}
+
+// setupAccOwner creates the owner of a fresh instance and returns an API
+// key of it
+func setupAccOwner(endpoint string) (string, error) {
+	c := client.NewClient(endpoint, "")
+	session, err := c.SetupOwner(&client.OwnerSetupRequest{
+		Email:     "owner@example.com",
+		FirstName: "Acceptance",
+		LastName:  "Test",
+		Password:  "Acceptance-Test-1",
+	})
+	if err != nil {
+		return "", fmt.Errorf("setting up the owner: %w", err)
+	}
+
+	key, err := c.CreateSessionAPIKey(session, &client.CreateAPIKeyRequest{Label: "acceptance"})
+	if err != nil {
+		return "", fmt.Errorf("creating an API key: %w", err)
+	}
+	return key.APIKey, nil
+}
+
+// testAccPreCheck skips acceptance tests unless they are enabled. The
+// helpers below call it too, since they talk to n8n before resource.Test
+// would skip the test.
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+}
+
+// testAccClient returns a client for the instance the tests run against
+func testAccClient(t *testing.T) *client.Client {
+	t.Helper()
+	testAccPreCheck(t)
+	return client.NewClient(os.Getenv("N8N_ENDPOINT"), os.Getenv("N8N_API_KEY"))
+}
+
+// testAccRequireFeature skips a test unless the license of the instance
+// enables a feature
+func testAccRequireFeature(t *testing.T, feature string, enabled func(*client.EnterpriseFeatures) bool) {
+	t.Helper()
+	info, err := testAccClient(t).GetInstanceInfo()
+	if err != nil {
+		t.Fatalf("GetInstanceInfo() error = %v", err)
+	}
+	if !enabled(&info.Enterprise) {
+		t.Skipf("The license of the instance does not enable %s", feature)
+	}
+}
+
+// testAccProject creates a team project for a test and deletes it when the
+// test ends
+func testAccProject(t *testing.T) string {
+	t.Helper()
+	testAccRequireFeature(t, "team projects", (*client.EnterpriseFeatures).TeamProjects)
+
+	c := testAccClient(t)
+	project, err := c.CreateProject(testAccName(t))
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	t.Cleanup(func() {
+		if err := c.DeleteProject(project.ID); err != nil {
+			t.Errorf("DeleteProject() error = %v", err)
+		}
+	})
+	return project.ID
+}
+
+// testAccPersonalProjectID returns the personal project of the API key
+// owner. Listing projects needs a license, so it is taken from a workflow,
+// which n8n creates in that project.
+func testAccPersonalProjectID(t *testing.T) string {
+	t.Helper()
+	c := testAccClient(t)
+	workflow, err := c.CreateWorkflow(&client.Workflow{
+		Name:        testAccName(t),
+		Nodes:       []interface{}{},
+		Connections: map[string]interface{}{},
+		Settings:    map[string]interface{}{},
+	})
+	if err != nil {
+		t.Fatalf("CreateWorkflow() error = %v", err)
+	}
+	if err := deleteWorkflow(c, workflow.ID); err != nil {
+		t.Fatalf("deleteWorkflow() error = %v", err)
+	}
+
+	projectID := workflow.OwnerProjectID()
+	if projectID == "" {
+		t.Skip("The n8n instance does not report the project of workflows")
+	}
+	return projectID
+}
+
+// testAccTag returns the ID of the tag with the given name, creating it if
+// needed. Tags are kept for later runs instead of being deleted.
+func testAccTag(t *testing.T, name string) string {
+	t.Helper()
+	c := testAccClient(t)
+	tags, err := c.ListTags()
+	if err != nil {
+		t.Fatalf("ListTags() error = %v", err)
+	}
+	for _, tag := range tags {
+		if tag.Name == name {
+			return tag.ID
+		}
+	}
+
+	tag, err := c.CreateTag(name)
+	if err != nil {
+		t.Fatalf("CreateTag() error = %v", err)
+	}
+	return tag.ID
+}
+
+// testAccRequireEnv skips a test unless the environment variable is set
+// and returns its value
+func testAccRequireEnv(t *testing.T, name string) string {
+	t.Helper()
+	value := os.Getenv(name)
+	if value == "" {
+		t.Skipf("Set %s to run this test", name)
+	}
+	return value
+}
+
+// testAccExpectAction returns plan checks that a step plans the given action
+// for a resource
+func testAccExpectAction(address string, action plancheck.ResourceActionType) resource.ConfigPlanChecks {
+	return resource.ConfigPlanChecks{
+		PreApply: []plancheck.PlanCheck{
+			plancheck.ExpectResourceAction(address, action),
+		},
+	}
+}
+
+// testAccName returns a name for objects a test creates, unique to the test
+func testAccName(t *testing.T) string {
+	return "tf-acc " + strings.ReplaceAll(t.Name(), "/", " ")
+}
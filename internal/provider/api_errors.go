@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// licenseHint explains a failed request that the license of the instance
+// does not allow, or returns an empty string for other errors.
+func licenseHint(err error, feature string) string {
+	if !client.IsLicenseRequired(err) {
+		return ""
+	}
+	return "\n\nThis requires the n8n enterprise " + feature + " feature, which the license of the instance does not include."
+}
+
+// addWorkflowError reports a failed workflow request. Errors about a single
+// node are attached to the nodes attribute so Terraform points at it.
+func addWorkflowError(diags *diag.Diagnostics, summary, detail string, err error) {
+	if client.ErrorNode(err) != "" {
+		diags.AddAttributeError(path.Root("nodes"), summary, detail)
+		return
+	}
+	diags.AddError(summary, detail)
+}
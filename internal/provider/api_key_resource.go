@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiKeyResource{}
+	_ resource.ResourceWithConfigure   = &apiKeyResource{}
+	_ resource.ResourceWithImportState = &apiKeyResource{}
+)
+
+// NewAPIKeyResource is a helper function to simplify the provider implementation.
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource is the resource implementation.
+type apiKeyResource struct {
+	client client.APIKeysService
+}
+
+// apiKeyResourceModel maps the resource schema data.
+type apiKeyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Label     types.String `tfsdk:"label"`
+	Scopes    types.Set    `tfsdk:"scopes"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Keepers   types.Map    `tfsdk:"keepers"`
+	APIKey    types.String `tfsdk:"api_key"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an n8n public API key owned by the user the provider authenticates as. " +
+			"The key itself is only returned by n8n when it is created; rotate it by changing keepers or expires_at, which replaces the key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "API key identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the API key",
+				Required:    true,
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Scopes granted to the key, e.g. 'workflow:read'. Defaults to the scopes allowed for the user's role.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiration time in RFC 3339 format. The key does not expire if not set. Changing this creates a new key.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary values that cause the key to be rotated (replaced) when changed",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Description: "The API key. Only known for keys created by Terraform; empty after import.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the key was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.APIKeysService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &apiKeyResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan apiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := &client.CreateAPIKeyRequest{
+		Label: plan.Label.ValueString(),
+	}
+	resp.Diagnostics.Append(apiKeyScopesFromModel(ctx, &plan, &createReq.Scopes)...)
+
+	if !plan.ExpiresAt.IsNull() {
+		expiresAt, err := time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Invalid Expiration Time",
+				"expires_at must be an RFC 3339 timestamp: "+err.Error(),
+			)
+			return
+		}
+		unix := expiresAt.Unix()
+		createReq.ExpiresAt = &unix
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.CreateAPIKey(createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating n8n API Key",
+			"Could not create API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(key.ID)
+	plan.APIKey = types.StringValue(key.APIKey)
+	plan.CreatedAt = types.StringValue(key.CreatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &apiKeyResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetAPIKey(state.ID.ValueString())
+	if err != nil {
+		// If the key was revoked, remove from state
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading n8n API Key",
+			"Could not read API key ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Label = types.StringValue(key.Label)
+	state.CreatedAt = types.StringValue(key.CreatedAt)
+	// Unset scopes are left alone unless the key is being imported
+	if !state.Scopes.IsNull() || (state.APIKey.IsNull() && len(key.Scopes) > 0) {
+		scopes, d := types.SetValueFrom(ctx, types.StringType, key.Scopes)
+		resp.Diagnostics.Append(d...)
+		state.Scopes = scopes
+	}
+	// Keep the expiration of an imported key, which cannot change afterwards
+	if state.APIKey.IsNull() && key.ExpiresAt != nil {
+		state.ExpiresAt = types.StringValue(time.Unix(*key.ExpiresAt, 0).UTC().Format(time.RFC3339))
+	}
+	// The API only returns a redacted key, so keep the one from creation
+	if state.APIKey.IsNull() {
+		state.APIKey = types.StringValue("")
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &apiKeyResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan apiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := &client.UpdateAPIKeyRequest{
+		Label: plan.Label.ValueString(),
+	}
+	resp.Diagnostics.Append(apiKeyScopesFromModel(ctx, &plan, &updateReq.Scopes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateAPIKey(plan.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating n8n API Key",
+			"Could not update API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &apiKeyResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAPIKey(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting n8n API Key",
+			"Could not delete API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apiKeyScopesFromModel converts the configured scopes, leaving dst nil when unset.
+func apiKeyScopesFromModel(ctx context.Context, model *apiKeyResourceModel, dst *[]string) diag.Diagnostics {
+	if model.Scopes.IsNull() || model.Scopes.IsUnknown() {
+		return nil
+	}
+	return model.Scopes.ElementsAs(ctx, dst, false)
+}
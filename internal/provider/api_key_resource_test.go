@@ -0,0 +1,53 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccAPIKeyResource(t *testing.T) {
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("n8n_api_key.test", "id"),
+		resource.TestCheckResourceAttrSet("n8n_api_key.test", "api_key"),
+		resource.TestCheckResourceAttrSet("n8n_api_key.test", "created_at"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "n8n_api_key" "test" {
+  label = "tf-acc api key"
+}
+`,
+				Check: check,
+			},
+			{
+				Config: `
+resource "n8n_api_key" "test" {
+  label = "tf-acc api key renamed"
+}
+`,
+				ConfigPlanChecks: testAccExpectAction("n8n_api_key.test", plancheck.ResourceActionUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					check,
+					resource.TestCheckResourceAttr("n8n_api_key.test", "label", "tf-acc api key renamed"),
+				),
+			},
+			{
+				ResourceName:      "n8n_api_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The full key is only returned on creation and scopes are
+				// only read on import when they are not configured
+				ImportStateVerifyIgnore: []string{"api_key", "scopes"},
+			},
+		},
+	})
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &auditDataSource{}
+	_ datasource.DataSourceWithConfigure = &auditDataSource{}
+)
+
+// NewAuditDataSource is a helper function to simplify the provider implementation.
+func NewAuditDataSource() datasource.DataSource {
+	return &auditDataSource{}
+}
+
+// auditDataSource is the data source implementation.
+type auditDataSource struct {
+	client client.AuditService
+}
+
+// auditDataSourceModel maps the data source schema data.
+type auditDataSourceModel struct {
+	Categories            types.Set           `tfsdk:"categories"`
+	DaysAbandonedWorkflow types.Int64         `tfsdk:"days_abandoned_workflow"`
+	Report                types.String        `tfsdk:"report"`
+	Findings              []auditFindingModel `tfsdk:"findings"`
+}
+
+// auditFindingModel maps a single finding of the audit.
+type auditFindingModel struct {
+	Risk           types.String `tfsdk:"risk"`
+	Title          types.String `tfsdk:"title"`
+	Description    types.String `tfsdk:"description"`
+	Recommendation types.String `tfsdk:"recommendation"`
+	Locations      types.Int64  `tfsdk:"locations"`
+}
+
+// Metadata returns the data source type name.
+func (d *auditDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit"
+}
+
+// Schema defines the schema for the data source.
+func (d *auditDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an n8n security audit and returns its findings.",
+		Attributes: map[string]schema.Attribute{
+			"categories": schema.SetAttribute{
+				Description: "Risk categories to audit: 'credentials', 'database', 'nodes', 'filesystem' and 'instance'. Defaults to all of them.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("credentials", "database", "nodes", "filesystem", "instance")),
+				},
+			},
+			"days_abandoned_workflow": schema.Int64Attribute{
+				Description: "Number of days without executions after which a workflow counts as abandoned. Defaults to n8n's default of 90.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"report": schema.StringAttribute{
+				Description: "Complete audit report as returned by n8n, as a JSON string",
+				Computed:    true,
+			},
+			"findings": schema.ListNestedAttribute{
+				Description: "Findings of the audit across all categories",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"risk": schema.StringAttribute{
+							Description: "Category of the finding",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "Title of the finding",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the finding",
+							Computed:    true,
+						},
+						"recommendation": schema.StringAttribute{
+							Description: "Recommended action",
+							Computed:    true,
+						},
+						"locations": schema.Int64Attribute{
+							Description: "Number of places (workflows, nodes, credentials, ...) the finding applies to; see report for the details",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *auditDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.AuditService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *auditDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &auditDataSource{client: withContext(d.client, ctx)}
+
+	var state auditDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := client.AuditOptions{
+		DaysAbandonedWorkflow: state.DaysAbandonedWorkflow.ValueInt64(),
+	}
+	if !state.Categories.IsNull() {
+		resp.Diagnostics.Append(state.Categories.ElementsAs(ctx, &options.Categories, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	reports, err := d.client.GenerateAudit(options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating n8n Audit",
+			"Could not generate security audit: "+err.Error(),
+		)
+		return
+	}
+
+	reportJSON, err := json.Marshal(reports)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshaling audit report",
+			"Could not marshal audit report to JSON: "+err.Error(),
+		)
+		return
+	}
+	state.Report = types.StringValue(string(reportJSON))
+
+	// List the findings in a stable order
+	state.Findings = []auditFindingModel{}
+	for _, name := range sortedKeys(reports) {
+		report := reports[name]
+		for _, section := range report.Sections {
+			state.Findings = append(state.Findings, auditFindingModel{
+				Risk:           types.StringValue(report.Risk),
+				Title:          types.StringValue(section.Title),
+				Description:    types.StringValue(section.Description),
+				Recommendation: types.StringValue(section.Recommendation),
+				Locations:      types.Int64Value(int64(len(section.Location))),
+			})
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &buildConnectionsFunction{}
+)
+
+// NewBuildConnectionsFunction is a helper function to simplify the provider implementation.
+func NewBuildConnectionsFunction() function.Function {
+	return &buildConnectionsFunction{}
+}
+
+// buildConnectionsFunction converts chains of node names into an n8n
+// connections object.
+type buildConnectionsFunction struct{}
+
+// Metadata returns the function name.
+func (f *buildConnectionsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_connections"
+}
+
+// Definition defines the parameters and return type for the function.
+func (f *buildConnectionsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an n8n connections object from chains of node names",
+		Description: "Converts a list of node name chains into the JSON connections object used by n8n workflows. " +
+			"Each chain connects its nodes in order through their main output, so [\"Webhook\", \"Set\", \"Slack\"] connects Webhook to Set and Set to Slack. " +
+			"Several chains starting from the same node fan out from its main output. The result can be passed directly to the connections attribute of n8n_workflow.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "chains",
+				Description: "List of chains, each a list of node names in execution order",
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// connectionTarget is a single edge in the n8n connections object.
+type connectionTarget struct {
+	Node  string `json:"node"`
+	Type  string `json:"type"`
+	Index int    `json:"index"`
+}
+
+// Run builds the connections JSON.
+func (f *buildConnectionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var chains [][]string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &chains))
+	if resp.Error != nil {
+		return
+	}
+
+	connections, err := buildConnections(chains)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	connectionsJSON, err := json.Marshal(connections)
+	if err != nil {
+		resp.Error = function.NewFuncError("Could not marshal connections to JSON: " + err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(connectionsJSON)))
+}
+
+// buildConnections turns chains of node names into the n8n connections
+// structure: source node -> "main" -> output index -> targets.
+func buildConnections(chains [][]string) (map[string]map[string][][]connectionTarget, error) {
+	connections := make(map[string]map[string][][]connectionTarget)
+
+	for i, chain := range chains {
+		for j, name := range chain {
+			if name == "" {
+				return nil, fmt.Errorf("chain %d contains an empty node name at position %d", i, j)
+			}
+		}
+
+		for j := 0; j+1 < len(chain); j++ {
+			source, target := chain[j], chain[j+1]
+
+			if _, ok := connections[source]; !ok {
+				connections[source] = map[string][][]connectionTarget{
+					"main": {{}},
+				}
+			}
+
+			outputs := connections[source]["main"]
+			duplicate := false
+			for _, existing := range outputs[0] {
+				if existing.Node == target {
+					duplicate = true
+					break
+				}
+			}
+			if !duplicate {
+				outputs[0] = append(outputs[0], connectionTarget{Node: target, Type: "main", Index: 0})
+			}
+		}
+	}
+
+	return connections, nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestBuildConnections(t *testing.T) {
+	tests := map[string]struct {
+		chains  [][]string
+		want    string
+		wantErr string
+	}{
+		"no chains": {
+			want: `{}`,
+		},
+		"single node": {
+			chains: [][]string{{"Start"}},
+			want:   `{}`,
+		},
+		"chain": {
+			chains: [][]string{{"Webhook", "Set", "Slack"}},
+			want: `{
+				"Webhook": {"main": [[{"node": "Set", "type": "main", "index": 0}]]},
+				"Set": {"main": [[{"node": "Slack", "type": "main", "index": 0}]]}
+			}`,
+		},
+		"fan out": {
+			chains: [][]string{{"Webhook", "Slack"}, {"Webhook", "Email"}},
+			want: `{
+				"Webhook": {"main": [[
+					{"node": "Slack", "type": "main", "index": 0},
+					{"node": "Email", "type": "main", "index": 0}
+				]]}
+			}`,
+		},
+		"fan in": {
+			chains: [][]string{{"Schedule", "Merge"}, {"Webhook", "Merge"}},
+			want: `{
+				"Schedule": {"main": [[{"node": "Merge", "type": "main", "index": 0}]]},
+				"Webhook": {"main": [[{"node": "Merge", "type": "main", "index": 0}]]}
+			}`,
+		},
+		"duplicate edges": {
+			chains: [][]string{{"Webhook", "Slack"}, {"Webhook", "Slack", "Log"}},
+			want: `{
+				"Webhook": {"main": [[{"node": "Slack", "type": "main", "index": 0}]]},
+				"Slack": {"main": [[{"node": "Log", "type": "main", "index": 0}]]}
+			}`,
+		},
+		"empty name": {
+			chains:  [][]string{{"Webhook", "Slack"}, {"Webhook", ""}},
+			wantErr: "chain 1 contains an empty node name at position 1",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			connections, err := buildConnections(tt.chains)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("buildConnections() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildConnections() error = %v", err)
+			}
+			got, err := json.Marshal(connections)
+			if err != nil {
+				t.Fatalf("marshalling connections: %v", err)
+			}
+			var gotValue, wantValue any
+			if err := json.Unmarshal(got, &gotValue); err != nil {
+				t.Fatalf("unmarshalling connections: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &wantValue); err != nil {
+				t.Fatalf("unmarshalling want: %v", err)
+			}
+			if !reflect.DeepEqual(gotValue, wantValue) {
+				t.Errorf("buildConnections() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
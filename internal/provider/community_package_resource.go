@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &communityPackageResource{}
+	_ resource.ResourceWithConfigure   = &communityPackageResource{}
+	_ resource.ResourceWithImportState = &communityPackageResource{}
+)
+
+// NewCommunityPackageResource is a helper function to simplify the provider implementation.
+func NewCommunityPackageResource() resource.Resource {
+	return &communityPackageResource{}
+}
+
+// communityPackageResource is the resource implementation.
+type communityPackageResource struct {
+	client client.CommunityPackagesService
+}
+
+// communityPackageResourceModel maps the resource schema data.
+type communityPackageResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	PackageName      types.String `tfsdk:"package_name"`
+	Version          types.String `tfsdk:"version"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+	UpdateAvailable  types.String `tfsdk:"update_available"`
+	NodeTypes        types.List   `tfsdk:"node_types"`
+}
+
+// Metadata returns the resource type name.
+func (r *communityPackageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_community_package"
+}
+
+// Schema defines the schema for the resource.
+func (r *communityPackageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an installed n8n community node package. Community packages must be enabled on the instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Package identifier (the package name)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				Description: "npm package name, e.g. 'n8n-nodes-example'",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version to pin the package to. If not set, the latest version is installed and not upgraded afterwards.",
+				Optional:    true,
+			},
+			"installed_version": schema.StringAttribute{
+				Description: "Currently installed version",
+				Computed:    true,
+			},
+			"update_available": schema.StringAttribute{
+				Description: "Newer version available for the package, if any",
+				Computed:    true,
+			},
+			"node_types": schema.ListAttribute{
+				Description: "Node types provided by the package, for use in workflow definitions",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *communityPackageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.CommunityPackagesService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *communityPackageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &communityPackageResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan communityPackageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pkg, err := r.client.InstallCommunityPackage(plan.PackageName.ValueString(), plan.Version.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Installing n8n Community Package",
+			"Could not install community package "+plan.PackageName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(pkg.PackageName)
+	resp.Diagnostics.Append(setCommunityPackageComputed(pkg, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *communityPackageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &communityPackageResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state communityPackageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pkg, err := r.client.GetCommunityPackage(state.ID.ValueString())
+	if err != nil {
+		// If the package was uninstalled, remove from state
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Community Package",
+			"Could not read community package "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only a pinned version is compared against the installed one; an
+	// imported package is pinned to the version that is installed
+	if !state.Version.IsNull() || state.PackageName.IsNull() {
+		state.Version = types.StringValue(pkg.InstalledVersion)
+	}
+	state.PackageName = types.StringValue(pkg.PackageName)
+	resp.Diagnostics.Append(setCommunityPackageComputed(pkg, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *communityPackageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &communityPackageResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan communityPackageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state communityPackageResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unpinning keeps the installed version; only a new pin triggers an update
+	if plan.Version.IsNull() || plan.Version.Equal(state.Version) {
+		plan.InstalledVersion = state.InstalledVersion
+		plan.UpdateAvailable = state.UpdateAvailable
+		plan.NodeTypes = state.NodeTypes
+
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	pkg, err := r.client.UpdateCommunityPackage(plan.ID.ValueString(), plan.Version.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating n8n Community Package",
+			"Could not update community package "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setCommunityPackageComputed(pkg, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *communityPackageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &communityPackageResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state communityPackageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UninstallCommunityPackage(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Uninstalling n8n Community Package",
+			"Could not uninstall community package, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *communityPackageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCommunityPackageComputed copies the computed attributes from the API response.
+func setCommunityPackageComputed(pkg *client.CommunityPackage, model *communityPackageResourceModel) diag.Diagnostics {
+	model.InstalledVersion = types.StringValue(pkg.InstalledVersion)
+	model.UpdateAvailable = types.StringValue(pkg.UpdateAvailable)
+
+	nodeTypes := make([]attr.Value, 0, len(pkg.InstalledNodes))
+	for _, node := range pkg.InstalledNodes {
+		nodeTypes = append(nodeTypes, types.StringValue(node.Type))
+	}
+	list, diags := types.ListValue(types.StringType, nodeTypes)
+	model.NodeTypes = list
+
+	return diags
+}
@@ -0,0 +1,40 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCommunityPackageResource(t *testing.T) {
+	// Installing a package downloads it from npm
+	packageName := testAccRequireEnv(t, "N8N_ACC_COMMUNITY_PACKAGE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "n8n_community_package" "test" {
+  package_name = %q
+}
+`, packageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_community_package.test", "package_name", packageName),
+					resource.TestCheckResourceAttrSet("n8n_community_package.test", "installed_version"),
+				),
+			},
+			{
+				ResourceName:      "n8n_community_package.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The installed version is only pinned on import
+				ImportStateVerifyIgnore: []string{"version"},
+			},
+		},
+	})
+}
@@ -24,7 +24,7 @@ func NewCredentialDataSource() datasource.DataSource {
 
 // credentialDataSource is the data source implementation.
 type credentialDataSource struct {
-	client *client.Client
+	client client.CredentialsService
 }
 
 // credentialDataSourceModel maps the data source schema data.
@@ -72,12 +72,12 @@ func (d *credentialDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.CredentialsService)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
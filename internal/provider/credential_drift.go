@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// credentialTypePattern matches credential type names such as 'slackApi'
+// or 'httpBasicAuth', as opposed to node types such as 'n8n-nodes-base.slack'.
+var credentialTypePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// credentialTypeValidator checks that a value looks like a credential type name.
+func credentialTypeValidator() validator.String {
+	return stringvalidator.RegexMatches(
+		credentialTypePattern,
+		"must be a credential type name such as 'slackApi' or 'httpBasicAuth', not a node type",
+	)
+}
+
+// How the credential resource reacts when a credential was changed outside
+// of Terraform.
+const (
+	credentialDriftWarn   = "warn"
+	credentialDriftError  = "error"
+	credentialDriftIgnore = "ignore"
+)
+
+// credentialDataHash returns a salted SHA-256 hash of the credential data
+// JSON as "sha256:<salt>:<hash>". The data is decoded and encoded again
+// first, so formatting and key order do not change the hash. A new salt is
+// generated when salt is empty.
+func credentialDataHash(dataJSON, salt string) (string, error) {
+	if salt == "" {
+		saltBytes := make([]byte, 16)
+		if _, err := rand.Read(saltBytes); err != nil {
+			return "", err
+		}
+		salt = hex.EncodeToString(saltBytes)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(dataJSON), &data); err != nil {
+		return "", err
+	}
+	canonical, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(append([]byte(salt), canonical...))
+	return "sha256:" + salt + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+// credentialDataHashSalt returns the salt of a hash made by
+// credentialDataHash, or an empty string if it is not one.
+func credentialDataHashSalt(hash string) string {
+	parts := strings.Split(hash, ":")
+	if len(parts) != 3 || parts[0] != "sha256" {
+		return ""
+	}
+	return parts[1]
+}
+
+// credentialMetadata returns the creation time, scopes, and the projects a
+// credential is shared with, not including its owner. Values n8n did not
+// report are null.
+func credentialMetadata(credential *client.Credential) (types.String, types.Set, types.Set) {
+	createdAt := types.StringNull()
+	if credential.CreatedAt != "" {
+		createdAt = types.StringValue(credential.CreatedAt)
+	}
+
+	scopes := types.SetNull(types.StringType)
+	if credential.Scopes != nil {
+		scopes = types.SetValueMust(types.StringType, stringValues(credential.Scopes))
+	}
+
+	sharedWith := types.SetNull(types.StringType)
+	if credential.Shared != nil {
+		projectIDs := []string{}
+		for _, shared := range credential.Shared {
+			if shared.Role != "credential:owner" {
+				projectIDs = append(projectIDs, shared.ProjectID)
+			}
+		}
+		sharedWith = types.SetValueMust(types.StringType, stringValues(projectIDs))
+	}
+
+	return createdAt, scopes, sharedWith
+}
+
+// findCredential returns the credential with the given ID from a fresh
+// credential list, or nil if it is not listed. The n8n API cannot read a
+// single credential; refreshes use RefreshCredential, which lists the
+// credentials only once.
+func findCredential(c client.CredentialsService, id string) (*client.Credential, error) {
+	credentials, err := c.ListCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range credentials {
+		if credentials[i].ID == id {
+			return &credentials[i], nil
+		}
+	}
+	return nil, nil
+}
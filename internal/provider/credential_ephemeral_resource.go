@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// credentialIDPrivateKey is the private state key holding the ID of the
+// credential an ephemeral credential created.
+const credentialIDPrivateKey = "credential_id"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &credentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &credentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &credentialEphemeralResource{}
+)
+
+// NewCredentialEphemeralResource is a helper function to simplify the provider implementation.
+func NewCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &credentialEphemeralResource{}
+}
+
+// credentialEphemeralResource is the ephemeral resource implementation.
+type credentialEphemeralResource struct {
+	client client.CredentialsService
+}
+
+// credentialEphemeralResourceModel maps the ephemeral resource schema data.
+type credentialEphemeralResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Data      types.String `tfsdk:"data"`
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *credentialEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *credentialEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived n8n credential, for example a token scoped to a CI run, and deletes it again once Terraform is done with it. " +
+			"Terraform opens ephemeral resources during both plan and apply, so a new credential is created and deleted in each run. " +
+			"Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Credential identifier",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the credential",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the credential (e.g., 'httpBasicAuth', 'slackApi', etc.)",
+				Required:    true,
+				Validators: []validator.String{
+					credentialTypeValidator(),
+				},
+			},
+			"data": schema.StringAttribute{
+				Description: "JSON string representing the credential data",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "ID of the project the credential is created in. If not set, the credential is created in the personal project of the API key owner.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *credentialEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.CredentialsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Open creates the credential.
+func (r *credentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Retrieve values from config
+	var config credentialEphemeralResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse JSON string for data
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(config.Data.ValueString()), &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing data JSON",
+			"Could not parse data JSON: "+err.Error(),
+		)
+		return
+	}
+
+	createdCredential, err := withContext(r.client, ctx).CreateCredential(&client.Credential{
+		Name:      config.Name.ValueString(),
+		Type:      config.Type.ValueString(),
+		Data:      data,
+		ProjectID: config.ProjectID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating credential",
+			"Could not create credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Terraform does not call Close after a failed Open, so delete the
+	// credential here if any of the following steps fail
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		if err := withContext(r.client, ctx).DeleteCredential(createdCredential.ID); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting n8n Credential",
+				"Could not delete ephemeral credential ID "+createdCredential.ID+" after opening it failed, it must be deleted manually: "+err.Error(),
+			)
+		}
+	}()
+
+	// Record the credential for Close, which deletes it once Terraform no
+	// longer needs it
+	credentialID, err := json.Marshal(createdCredential.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshaling credential ID",
+			"Could not marshal credential ID to JSON: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, credentialIDPrivateKey, credentialID)...)
+
+	// Move the credential into its project on n8n versions that ignore the
+	// project of a new credential
+	if !config.ProjectID.IsNull() && config.ProjectID.ValueString() != createdCredential.OwnerProjectID() {
+		if err := withContext(r.client, ctx).TransferCredential(createdCredential.ID, config.ProjectID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Transferring n8n Credential",
+				"Credential ID "+createdCredential.ID+" was created but could not be transferred to project "+config.ProjectID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(createdCredential.ID)
+
+	// Set the ephemeral result
+	diags = resp.Result.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Close deletes the credential created by Open.
+func (r *credentialEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	credentialID, diags := req.Private.GetKey(ctx, credentialIDPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || credentialID == nil {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(credentialID, &id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing credential ID",
+			"Could not parse the ID of the credential to delete: "+err.Error(),
+		)
+		return
+	}
+
+	if err := withContext(r.client, ctx).DeleteCredential(id); err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting n8n Credential",
+			"Could not delete ephemeral credential ID "+id+", it must be deleted manually: "+err.Error(),
+		)
+	}
+}
@@ -2,14 +2,24 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/pinotelio/terraform-provider-n8n/internal/client"
@@ -17,9 +27,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &credentialResource{}
-	_ resource.ResourceWithConfigure   = &credentialResource{}
-	_ resource.ResourceWithImportState = &credentialResource{}
+	_ resource.Resource                     = &credentialResource{}
+	_ resource.ResourceWithConfigure        = &credentialResource{}
+	_ resource.ResourceWithImportState      = &credentialResource{}
+	_ resource.ResourceWithIdentity         = &credentialResource{}
+	_ resource.ResourceWithConfigValidators = &credentialResource{}
+	_ resource.ResourceWithModifyPlan       = &credentialResource{}
 )
 
 // NewCredentialResource is a helper function to simplify the provider implementation.
@@ -29,7 +42,13 @@ func NewCredentialResource() resource.Resource {
 
 // credentialResource is the resource implementation.
 type credentialResource struct {
-	client *client.Client
+	client credentialResourceClient
+}
+
+// credentialResourceClient is the part of the n8n API the credential resource uses.
+type credentialResourceClient interface {
+	client.CredentialsService
+	client.Endpoints
 }
 
 // credentialResourceModel maps the resource schema data.
@@ -38,6 +57,21 @@ type credentialResourceModel struct {
 	Name types.String `tfsdk:"name"`
 	Type types.String `tfsdk:"type"`
 	Data types.String `tfsdk:"data"`
+
+	DataWO         types.String `tfsdk:"data_wo"`
+	DataWOVersion  types.Int64  `tfsdk:"data_wo_version"`
+	RotateWhen     types.String `tfsdk:"rotate_when"`
+	DataHash       types.String `tfsdk:"data_hash"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	Scopes         types.Set    `tfsdk:"scopes"`
+	SharedWith     types.Set    `tfsdk:"shared_with"`
+	OnDrift        types.String `tfsdk:"on_drift"`
+	VerifyOnCreate types.Bool   `tfsdk:"verify_on_create"`
+
+	ProjectID types.String `tfsdk:"project_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -46,7 +80,7 @@ func (r *credentialResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *credentialResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an n8n credential.",
 		Attributes: map[string]schema.Attribute{
@@ -65,36 +99,183 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of the credential (e.g., 'httpBasicAuth', 'slackApi', etc.). Changing this forces a new credential.",
+				Description: "Type of the credential (e.g., 'httpBasicAuth', 'slackApi', etc.). Changing this forces a new credential, since n8n cannot change the type of a credential.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					credentialTypeValidator(),
+				},
 			},
 			"data": schema.StringAttribute{
-				Description: "JSON string representing the credential data. Changing this forces a new credential, since the n8n API cannot update credential data in place.",
-				Required:    true,
-				Sensitive:   true,
+				Description: "JSON string representing the credential data. Changing this forces a new credential, since the n8n API cannot update credential data in place. " +
+					"The data is stored in state; use data_wo to keep it out of state. Exactly one of data and data_wo must be set.",
+				Optional:  true,
+				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"data_wo": schema.StringAttribute{
+				Description: "Write-only JSON string representing the credential data. It is sent to n8n when the credential is created but never stored in state or plan, " +
+					"so changes to it are not detected; change data_wo_version to create the credential again with the new data. Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"data_wo_version": schema.Int64Attribute{
+				Description: "Version of data_wo. Changing this forces a new credential with the current data_wo, e.g. to rotate a secret.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_when": schema.StringAttribute{
+				Description: "Arbitrary value that forces a new credential with the current data when it changes, even if the data in the configuration did not change. " +
+					"Use it to rotate secrets that come from an external generator, e.g. a timestamp or version of the secret. Numbers are converted to strings.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data_hash": schema.StringAttribute{
+				Description: "Salted SHA-256 hash of the data submitted to n8n. It lets the plan warn when data_wo changes without a new data_wo_version, without storing the data itself.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the credential was created, e.g. to enforce rotation policies based on credential age",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Operations the API key owner may perform on the credential, e.g. 'credential:update', when reported by n8n",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shared_with": schema.SetAttribute{
+				Description: "IDs of the projects the credential is shared with, not including its owner, when reported by n8n",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp n8n reported for the last change of the credential when its data was submitted. A newer timestamp on refresh means the credential was edited outside of Terraform.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verify_on_create": schema.BoolAttribute{
+				Description: "Test the credential against its service after creating it, the way the n8n editor does, and fail the apply if it does not authenticate. " +
+					"A credential that fails the test is deleted again. Instances that do not accept API keys for the test only get a warning.",
+				Optional: true,
+			},
+			"on_drift": schema.StringAttribute{
+				Description: "What to do when a refresh finds that the credential was edited outside of Terraform, for example in the n8n UI: 'warn' adds a warning, " +
+					"'error' fails the refresh, and 'ignore' skips the check. Replace the credential to submit the configured data again. " +
+					"The check needs an n8n version that can list credentials. Defaults to 'warn'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(credentialDriftWarn),
+				Validators: []validator.String{
+					stringvalidator.OneOf(credentialDriftWarn, credentialDriftError, credentialDriftIgnore),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "ID of the project that owns the credential. The credential is created in this project, and changing it transfers the credential to the new project. " +
+					"If not set, the credential is created in the personal project of the API key owner and stays in whatever project it is in.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *credentialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("Credential identifier")
+}
+
+// ConfigValidators returns the validators for combinations of attributes.
+func (r *credentialResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("data"),
+			path.MatchRoot("data_wo"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("data"),
+			path.MatchRoot("data_wo_version"),
+		),
+	}
+}
+
+// ModifyPlan warns when data_wo changes without a new data_wo_version, in
+// which case the new data is not sent to n8n.
+func (r *credentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state credentialResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var dataWO, projectID types.String
+	var dataWOVersion types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &dataWO)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("data_wo_version"), &dataWOVersion)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A transfer changes the timestamp and sharing of the credential
+	if !projectID.IsNull() && !projectID.Equal(state.ProjectID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scopes"), types.SetUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("shared_with"), types.SetUnknown(types.StringType))...)
+	}
+
+	salt := credentialDataHashSalt(state.DataHash.ValueString())
+	if dataWO.IsNull() || dataWO.IsUnknown() || salt == "" || !dataWOVersion.Equal(state.DataWOVersion) {
+		return
+	}
+
+	if hash, err := credentialDataHash(dataWO.ValueString(), salt); err == nil && hash != state.DataHash.ValueString() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("data_wo_version"),
+			"Credential Data Changed Without New Version",
+			"data_wo differs from the data submitted to n8n, but data_wo_version is unchanged, so the new data is not sent. "+
+				"Change data_wo_version to replace the credential with the new data.",
+		)
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *credentialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(credentialResourceClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -113,21 +294,54 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &credentialResource{client: withContext(r.client, opCtx)}
+
+	// Write-only data is only available from the configuration
+	dataJSON := plan.Data.ValueString()
+	if plan.Data.IsNull() {
+		var dataWO types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &dataWO)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		dataJSON = dataWO.ValueString()
+	}
+
 	// Parse JSON string for data
 	var data map[string]interface{}
-	if err := json.Unmarshal([]byte(plan.Data.ValueString()), &data); err != nil {
+	if err := json.Unmarshal([]byte(dataJSON), &data); err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing data JSON",
 			"Could not parse data JSON: "+err.Error(),
 		)
 		return
 	}
+	dataHash, err := credentialDataHash(dataJSON, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error hashing data",
+			"Could not hash credential data: "+err.Error(),
+		)
+		return
+	}
+	plan.DataHash = types.StringValue(dataHash)
 
 	// Create new credential
 	credential := &client.Credential{
 		Name: plan.Name.ValueString(),
 		Type: plan.Type.ValueString(),
 		Data: data,
+		// Create the credential in its project right away; older n8n versions
+		// ignore this and the credential is transferred below
+		ProjectID: plan.ProjectID.ValueString(),
 	}
 
 	createdCredential, err := r.client.CreateCredential(credential)
@@ -141,6 +355,63 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(createdCredential.ID)
+	plan.UpdatedAt = types.StringValue(createdCredential.UpdatedAt)
+	plan.CreatedAt, plan.Scopes, plan.SharedWith = credentialMetadata(createdCredential)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+
+	// Move the credential into its project
+	if !plan.ProjectID.IsNull() && plan.ProjectID.ValueString() != createdCredential.OwnerProjectID() {
+		if err := r.client.TransferCredential(createdCredential.ID, plan.ProjectID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Transferring n8n Credential",
+				"Credential ID "+createdCredential.ID+" was created but could not be transferred to project "+plan.ProjectID.ValueString()+": "+err.Error(),
+			)
+			// Keep the created credential in state so it is not orphaned
+			plan.ProjectID = types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+
+		// Record the timestamp after the transfer, where the credential list
+		// is available
+		if credential, err := findCredential(r.client, createdCredential.ID); err == nil && credential != nil {
+			if credential.UpdatedAt != "" {
+				plan.UpdatedAt = types.StringValue(credential.UpdatedAt)
+			}
+			plan.CreatedAt, plan.Scopes, plan.SharedWith = credentialMetadata(credential)
+		}
+	}
+
+	// Catch wrong secrets right away rather than on the first workflow run
+	if plan.VerifyOnCreate.ValueBool() {
+		credential.ID = createdCredential.ID
+		result, err := r.client.TestCredential(credential)
+		switch {
+		case err != nil && (client.IsUnauthorized(err) || client.IsForbidden(err) || client.IsNotFound(err)):
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("verify_on_create"),
+				"n8n Credential Not Verified",
+				"The n8n instance does not support testing credentials with an API key, so credential ID "+createdCredential.ID+" was not tested: "+err.Error(),
+			)
+		case err != nil:
+			resp.Diagnostics.AddError(
+				"Error Testing n8n Credential",
+				"Could not test credential ID "+createdCredential.ID+": "+err.Error(),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		case !result.OK():
+			detail := "Credential ID " + createdCredential.ID + " did not pass its test: " + result.Message
+			if err := r.client.DeleteCredential(createdCredential.ID); err != nil {
+				// Keep the credential in state so it is not orphaned
+				resp.Diagnostics.AddError("n8n Credential Test Failed", detail+". It could not be deleted again: "+err.Error())
+				resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+				return
+			}
+			resp.Diagnostics.AddError("n8n Credential Test Failed", detail+". The credential was deleted again.")
+			return
+		}
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -160,8 +431,75 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// The n8n API can't read credentials back (no GET/LIST endpoint), so keep the
-	// existing state as-is.
+	// Bound the requests of this operation with its timeout
+	timeout, diags := state.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &credentialResource{client: withContext(r.client, opCtx)}
+
+	// Not set after an import
+	imported := state.Name.IsNull()
+	if state.OnDrift.IsNull() {
+		state.OnDrift = types.StringValue(credentialDriftWarn)
+	}
+
+	// The n8n API can't read credential data back, so keep the existing state
+	// as-is. After an import, fill in what the credential list tells, and
+	// otherwise use it to find changes made outside of Terraform.
+	if imported || state.OnDrift.ValueString() != credentialDriftIgnore {
+		credential, err := r.client.RefreshCredential(state.ID.ValueString())
+		switch {
+		case imported && err != nil:
+			resp.Diagnostics.AddError(
+				"Error Reading n8n Credential",
+				"Could not list credentials to import credential ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		case imported && credential == nil:
+			resp.Diagnostics.AddError(
+				"Error Reading n8n Credential",
+				"Credential ID "+state.ID.ValueString()+" was not found.",
+			)
+			return
+		case imported:
+			state.Name = types.StringValue(credential.Name)
+			state.Type = types.StringValue(credential.Type)
+			if projectID := credential.OwnerProjectID(); projectID != "" {
+				state.ProjectID = types.StringValue(projectID)
+			}
+			state.UpdatedAt = types.StringValue(credential.UpdatedAt)
+		case err == nil && credential == nil:
+			// The credential was deleted outside of Terraform
+			resp.State.RemoveResource(ctx)
+			return
+		case err != nil || credential.UpdatedAt == "":
+			// Older n8n versions cannot list credentials
+		case state.UpdatedAt.IsNull() || state.UpdatedAt.ValueString() == "":
+			// Not set by earlier provider versions
+			state.UpdatedAt = types.StringValue(credential.UpdatedAt)
+		case credential.UpdatedAt != state.UpdatedAt.ValueString():
+			summary := "n8n Credential Changed Outside of Terraform"
+			detail := "Credential ID " + state.ID.ValueString() + " was changed at " + credential.UpdatedAt +
+				", after its data was submitted at " + state.UpdatedAt.ValueString() + ". " +
+				"Its data may no longer match the configuration; replace the credential to submit the configured data again."
+			if state.OnDrift.ValueString() == credentialDriftError {
+				resp.Diagnostics.AddError(summary, detail)
+				return
+			}
+			resp.Diagnostics.AddWarning(summary, detail)
+		}
+
+		if err == nil && credential != nil {
+			state.CreatedAt, state.Scopes, state.SharedWith = credentialMetadata(credential)
+		}
+	}
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -169,13 +507,76 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
-// Update is unreachable: the n8n API has no credential update endpoint, so all
-// mutable attributes are RequiresReplace and the credential is replaced instead.
-func (r *credentialResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Credential update not supported",
-		"n8n credentials cannot be updated in place; they are replaced.",
-	)
+// Update transfers the credential to another project. The n8n API has no
+// credential update endpoint, so all other attributes are RequiresReplace.
+func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan credentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the requests of this operation with its timeout
+	timeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &credentialResource{client: withContext(r.client, opCtx)}
+
+	// Get current state
+	var state credentialResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unsetting project_id leaves the credential where it is
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.Equal(state.ProjectID) {
+		if err := r.client.TransferCredential(plan.ID.ValueString(), plan.ProjectID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Transferring n8n Credential",
+				"Could not transfer credential to project "+plan.ProjectID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		// Record the timestamp after the transfer, so it is not taken for a
+		// change made outside of Terraform
+		if credential, err := findCredential(r.client, plan.ID.ValueString()); err == nil && credential != nil {
+			if credential.UpdatedAt != "" {
+				plan.UpdatedAt = types.StringValue(credential.UpdatedAt)
+			}
+			plan.CreatedAt, plan.Scopes, plan.SharedWith = credentialMetadata(credential)
+		}
+	}
+
+	// Keep the known values when the credential list is not available
+	if plan.UpdatedAt.IsUnknown() {
+		plan.UpdatedAt = state.UpdatedAt
+	}
+	if plan.CreatedAt.IsUnknown() {
+		plan.CreatedAt = state.CreatedAt
+	}
+	if plan.Scopes.IsUnknown() {
+		plan.Scopes = state.Scopes
+	}
+	if plan.SharedWith.IsUnknown() {
+		plan.SharedWith = state.SharedWith
+	}
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -188,6 +589,16 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &credentialResource{client: withContext(r.client, opCtx)}
+
 	// Delete existing credential
 	err := r.client.DeleteCredential(state.ID.ValueString())
 	if err != nil {
@@ -201,6 +612,69 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState imports the resource state.
 func (r *credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	id, diags := importedResourceID(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Import IDs may carry the data n8n cannot return, as id,data=<base64 json>
+	id, options, _ := strings.Cut(id, ",")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if options == "" {
+		return
+	}
+
+	var dataJSON string
+	var dataWOVersion types.Int64
+	for _, option := range strings.Split(options, ",") {
+		key, value, _ := strings.Cut(option, "=")
+		switch key {
+		case "data":
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil || !json.Valid(decoded) {
+				resp.Diagnostics.AddError(
+					"Invalid Import ID",
+					"The data of the import ID must be base64 encoded JSON, e.g. the result of base64encode(jsonencode({...})).",
+				)
+				return
+			}
+			dataJSON = string(decoded)
+		case "data_wo_version":
+			version, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid Import ID", "data_wo_version of the import ID must be a number: "+err.Error())
+				return
+			}
+			dataWOVersion = types.Int64Value(version)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_wo_version"), dataWOVersion)...)
+		case "rotate_when":
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rotate_when"), value)...)
+		default:
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				"Unknown import ID option "+key+". Expected an import ID like id,data=<base64 json>, optionally with data_wo_version=<number> and rotate_when=<value>.",
+			)
+			return
+		}
+	}
+
+	if dataJSON == "" {
+		return
+	}
+	dataHash, err := credentialDataHash(dataJSON, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error hashing data",
+			"Could not hash credential data: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_hash"), dataHash)...)
+
+	// Write-only data is only recorded by its hash
+	if dataWOVersion.IsNull() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data"), dataJSON)...)
+	}
 }
@@ -0,0 +1,72 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccCredentialConfig(name string) string {
+	return fmt.Sprintf(`
+resource "n8n_credential" "test" {
+  name = %q
+  type = "httpBasicAuth"
+
+  data = jsonencode({
+    user     = "acceptance"
+    password = "acceptance"
+  })
+}
+`, name)
+}
+
+func TestAccCredentialResource(t *testing.T) {
+	const data = `{"password":"acceptance","user":"acceptance"}`
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("n8n_credential.test", "type", "httpBasicAuth"),
+		resource.TestCheckResourceAttrSet("n8n_credential.test", "id"),
+		resource.TestCheckResourceAttrSet("n8n_credential.test", "data_hash"),
+		resource.TestCheckResourceAttrSet("n8n_credential.test", "created_at"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialConfig("tf-acc credential"),
+				Check:  check,
+			},
+			{
+				// n8n cannot rename a credential in place
+				Config:           testAccCredentialConfig("tf-acc credential renamed"),
+				ConfigPlanChecks: testAccExpectAction("n8n_credential.test", plancheck.ResourceActionReplace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					check,
+					resource.TestCheckResourceAttr("n8n_credential.test", "name", "tf-acc credential renamed"),
+				),
+			},
+			{
+				ResourceName: "n8n_credential.test",
+				ImportState:  true,
+				// n8n does not return the data, so it is passed with the
+				// import ID
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["n8n_credential.test"]
+					if !ok {
+						return "", fmt.Errorf("n8n_credential.test is not in the state")
+					}
+					return rs.Primary.ID + ",data=" + base64.StdEncoding.EncodeToString([]byte(data)), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
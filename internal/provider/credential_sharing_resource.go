@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &credentialSharingResource{}
+	_ resource.ResourceWithConfigure   = &credentialSharingResource{}
+	_ resource.ResourceWithImportState = &credentialSharingResource{}
+)
+
+// NewCredentialSharingResource is a helper function to simplify the provider implementation.
+func NewCredentialSharingResource() resource.Resource {
+	return &credentialSharingResource{}
+}
+
+// credentialSharingResource is the resource implementation.
+type credentialSharingResource struct {
+	client client.CredentialsService
+}
+
+// credentialSharingResourceModel maps the resource schema data.
+type credentialSharingResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	CredentialID types.String `tfsdk:"credential_id"`
+	ProjectIDs   types.Set    `tfsdk:"project_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *credentialSharingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_sharing"
+}
+
+// Schema defines the schema for the resource.
+func (r *credentialSharingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the projects an n8n credential is shared with. This resource replaces all sharing of the credential. " +
+			"To share with a single user, use the ID of the user's personal project. Requires the n8n enterprise sharing feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier (same as credential_id)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credential_id": schema.StringAttribute{
+				Description: "The ID of the credential to share",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				Description: "IDs of the projects the credential is shared with, not including the project that owns it",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *credentialSharingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.CredentialsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *credentialSharingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &credentialSharingResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan credentialSharingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectIDs []string
+	resp.Diagnostics.Append(plan.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ShareCredential(plan.CredentialID.ValueString(), projectIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Sharing Credential",
+			"Could not share credential ID "+plan.CredentialID.ValueString()+": "+err.Error()+licenseHint(err, "sharing"),
+		)
+		return
+	}
+
+	// Set the ID to the credential ID
+	plan.ID = plan.CredentialID
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *credentialSharingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state credentialSharingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cancel the requests of this operation together with it
+	r = &credentialSharingResource{client: withContext(r.client, ctx)}
+
+	// Only the ID is known after an import
+	imported := state.ProjectIDs.IsNull()
+
+	// The sharing of a credential is only returned by the credential list
+	credential, err := r.client.RefreshCredential(state.CredentialID.ValueString())
+	switch {
+	case err != nil && imported:
+		resp.Diagnostics.AddError(
+			"Error Reading Credential Sharing",
+			"Could not list credentials to import the sharing of credential ID "+state.CredentialID.ValueString()+": "+err.Error(),
+		)
+		return
+	case err != nil:
+		// Older n8n versions cannot list credentials; keep the known sharing
+	case credential == nil:
+		// The credential was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	case credential.Shared != nil:
+		_, _, state.ProjectIDs = credentialMetadata(credential)
+	case imported:
+		resp.Diagnostics.AddError(
+			"Error Reading Credential Sharing",
+			"The n8n instance did not report the sharing of credential ID "+state.CredentialID.ValueString()+", so it cannot be imported.",
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *credentialSharingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &credentialSharingResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan credentialSharingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectIDs []string
+	resp.Diagnostics.Append(plan.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ShareCredential(plan.CredentialID.ValueString(), projectIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Sharing Credential",
+			"Could not share credential ID "+plan.CredentialID.ValueString()+": "+err.Error()+licenseHint(err, "sharing"),
+		)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *credentialSharingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &credentialSharingResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state credentialSharingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unshare from all projects; the owning project keeps the credential
+	if err := r.client.ShareCredential(state.CredentialID.ValueString(), []string{}); err != nil {
+		// If the credential doesn't exist, there is nothing to unshare
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Unsharing Credential",
+			"Could not unshare credential ID "+state.CredentialID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *credentialSharingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using credential ID
+	// Set both id and credential_id to the imported value
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credential_id"), req.ID)...)
+}
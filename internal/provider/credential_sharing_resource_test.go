@@ -0,0 +1,52 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+func TestAccCredentialSharingResource(t *testing.T) {
+	testAccRequireFeature(t, "sharing", func(f *client.EnterpriseFeatures) bool { return f.Sharing })
+	projectID := testAccProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "n8n_credential" "test" {
+  name = "tf-acc shared credential"
+  type = "httpBasicAuth"
+
+  data = jsonencode({
+    user     = "acceptance"
+    password = "acceptance"
+  })
+}
+
+resource "n8n_credential_sharing" "test" {
+  credential_id = n8n_credential.test.id
+  project_ids   = [%q]
+}
+`, projectID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_credential_sharing.test", "id"),
+					resource.TestCheckResourceAttrPair("n8n_credential_sharing.test", "credential_id", "n8n_credential.test", "id"),
+					resource.TestCheckTypeSetElemAttr("n8n_credential_sharing.test", "project_ids.*", projectID),
+				),
+			},
+			{
+				ResourceName:      "n8n_credential_sharing.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
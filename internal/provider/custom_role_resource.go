@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &customRoleResource{}
+	_ resource.ResourceWithConfigure   = &customRoleResource{}
+	_ resource.ResourceWithImportState = &customRoleResource{}
+)
+
+// NewCustomRoleResource is a helper function to simplify the provider implementation.
+func NewCustomRoleResource() resource.Resource {
+	return &customRoleResource{}
+}
+
+// customRoleResource is the resource implementation.
+type customRoleResource struct {
+	client client.RolesService
+}
+
+// customRoleResourceModel maps the resource schema data.
+type customRoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	RoleType    types.String `tfsdk:"role_type"`
+	Scopes      types.Set    `tfsdk:"scopes"`
+}
+
+// Metadata returns the resource type name.
+func (r *customRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_role"
+}
+
+// Schema defines the schema for the resource.
+func (r *customRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom n8n role. Requires the n8n enterprise custom roles feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Role slug generated by n8n, used to assign the role",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Name of the role shown in n8n",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the role",
+				Optional:    true,
+			},
+			"role_type": schema.StringAttribute{
+				Description: "Kind of role: 'project' (assigned through project membership) or 'global'. Defaults to project. Changing this creates a new role.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("project"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("project", "global"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Scopes granted by the role, e.g. 'workflow:read' or 'credential:create'",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.RolesService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *customRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &customRoleResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan customRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role := &client.Role{
+		DisplayName: plan.DisplayName.ValueString(),
+		Description: plan.Description.ValueString(),
+		RoleType:    plan.RoleType.ValueString(),
+	}
+	resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &role.Scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateRole(role)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating n8n Role",
+			"Could not create role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(created.Slug)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *customRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &customRoleResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state customRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.GetRole(state.ID.ValueString())
+	if err != nil {
+		// If the role doesn't exist, remove from state
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Role",
+			"Could not read n8n role "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.DisplayName = types.StringValue(role.DisplayName)
+	state.Description = optionalStringValue(state.Description, role.Description)
+	state.RoleType = types.StringValue(role.RoleType)
+	scopes, diags := types.SetValueFrom(ctx, types.StringType, role.Scopes)
+	resp.Diagnostics.Append(diags...)
+	state.Scopes = scopes
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *customRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &customRoleResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan customRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role := &client.Role{
+		DisplayName: plan.DisplayName.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &role.Scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateRole(plan.ID.ValueString(), role)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating n8n Role",
+			"Could not update role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &customRoleResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state customRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRole(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting n8n Role",
+			"Could not delete role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *customRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,57 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+func testAccCustomRoleConfig(scopes string) string {
+	return fmt.Sprintf(`
+resource "n8n_custom_role" "test" {
+  display_name = "tf-acc role"
+  description  = "Created by the acceptance tests"
+  role_type    = "project"
+  scopes       = %s
+}
+`, scopes)
+}
+
+func TestAccCustomRoleResource(t *testing.T) {
+	testAccRequireFeature(t, "advanced permissions", func(f *client.EnterpriseFeatures) bool { return f.AdvancedPermissions })
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("n8n_custom_role.test", "display_name", "tf-acc role"),
+		resource.TestCheckResourceAttr("n8n_custom_role.test", "role_type", "project"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoleConfig(`["workflow:read", "workflow:list"]`),
+				Check:  check,
+			},
+			{
+				Config:           testAccCustomRoleConfig(`["workflow:read", "workflow:list", "workflow:execute"]`),
+				ConfigPlanChecks: testAccExpectAction("n8n_custom_role.test", plancheck.ResourceActionUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					check,
+					resource.TestCheckTypeSetElemAttr("n8n_custom_role.test", "scopes.*", "workflow:execute"),
+				),
+			},
+			{
+				ResourceName:      "n8n_custom_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &executionPruneResource{}
+	_ resource.ResourceWithConfigure = &executionPruneResource{}
+)
+
+// NewExecutionPruneResource is a helper function to simplify the provider implementation.
+func NewExecutionPruneResource() resource.Resource {
+	return &executionPruneResource{}
+}
+
+// executionPruneResource is the resource implementation.
+type executionPruneResource struct {
+	client client.ExecutionsService
+}
+
+// executionPruneResourceModel maps the resource schema data.
+type executionPruneResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	OlderThanDays types.Int64  `tfsdk:"older_than_days"`
+	Status        types.String `tfsdk:"status"`
+	WorkflowID    types.String `tfsdk:"workflow_id"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	DeletedCount  types.Int64  `tfsdk:"deleted_count"`
+}
+
+// Metadata returns the resource type name.
+func (r *executionPruneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_execution_prune"
+}
+
+// Schema defines the schema for the resource.
+func (r *executionPruneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deletes the n8n executions matching the filters when created. " +
+			"The prune runs again whenever the filters or triggers change; destroying the resource does not restore anything.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Timestamp of the prune",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"older_than_days": schema.Int64Attribute{
+				Description: "Only delete executions that started more than this many days ago",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Only delete executions with this status: 'success', 'error', 'canceled' or 'waiting'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("success", "error", "canceled", "waiting"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				Description: "Only delete executions of this workflow",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that cause a new prune when changed, such as a timestamp from a scheduled pipeline",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"deleted_count": schema.Int64Attribute{
+				Description: "Number of executions deleted",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *executionPruneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ExecutionsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create deletes the matching executions and sets the initial Terraform state.
+func (r *executionPruneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &executionPruneResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan executionPruneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	executions, err := r.client.ListExecutions(client.ExecutionFilter{
+		WorkflowID: plan.WorkflowID.ValueString(),
+		Status:     plan.Status.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing n8n Executions",
+			"Could not list executions: "+err.Error(),
+		)
+		return
+	}
+
+	now := time.Now().UTC()
+	cutoff := now.AddDate(0, 0, -int(plan.OlderThanDays.ValueInt64()))
+
+	var deleted int64
+	for _, execution := range executions {
+		startedAt, err := time.Parse(time.RFC3339, execution.StartedAt)
+		if err != nil || !startedAt.Before(cutoff) {
+			continue
+		}
+
+		if err := r.client.DeleteExecution(execution.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting n8n Execution",
+				fmt.Sprintf("Could not delete execution %s after deleting %d executions: %s", execution.ID, deleted, err.Error()),
+			)
+			return
+		}
+		deleted++
+	}
+
+	plan.ID = types.StringValue(now.Format(time.RFC3339))
+	plan.DeletedCount = types.Int64Value(deleted)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read keeps the recorded prune; it is a one-time event.
+func (r *executionPruneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state executionPruneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is unreachable: every configurable attribute requires replacement.
+func (r *executionPruneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan executionPruneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the Terraform state only.
+func (r *executionPruneResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Deleted executions cannot be restored
+}
@@ -0,0 +1,34 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccExecutionPruneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "n8n_execution_prune" "test" {
+  older_than_days = 30
+  status          = "error"
+
+  triggers = {
+    run = "1"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_execution_prune.test", "id"),
+					resource.TestCheckResourceAttrSet("n8n_execution_prune.test", "deleted_count"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &executionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &executionsDataSource{}
+)
+
+// NewExecutionsDataSource is a helper function to simplify the provider implementation.
+func NewExecutionsDataSource() datasource.DataSource {
+	return &executionsDataSource{}
+}
+
+// executionsDataSource is the data source implementation.
+type executionsDataSource struct {
+	client client.ExecutionsService
+}
+
+// executionsDataSourceModel maps the data source schema data.
+type executionsDataSourceModel struct {
+	WorkflowID types.String     `tfsdk:"workflow_id"`
+	Status     types.String     `tfsdk:"status"`
+	Limit      types.Int64      `tfsdk:"limit"`
+	Since      types.String     `tfsdk:"since"`
+	Executions []executionModel `tfsdk:"executions"`
+}
+
+// executionModel maps a single execution.
+type executionModel struct {
+	ID         types.String `tfsdk:"id"`
+	WorkflowID types.String `tfsdk:"workflow_id"`
+	Mode       types.String `tfsdk:"mode"`
+	Status     types.String `tfsdk:"status"`
+	StartedAt  types.String `tfsdk:"started_at"`
+	StoppedAt  types.String `tfsdk:"stopped_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *executionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_executions"
+}
+
+// Schema defines the schema for the data source.
+func (d *executionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists workflow executions, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				Description: "Only return executions of this workflow",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return executions with this status: 'success', 'error', 'canceled', 'running' or 'waiting'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("success", "error", "canceled", "running", "waiting"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of executions to return. Defaults to all matching executions.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"since": schema.StringAttribute{
+				Description: "Only return executions started at or after this RFC 3339 timestamp, e.g. '2024-01-02T15:04:05Z'",
+				Optional:    true,
+			},
+			"executions": schema.ListNestedAttribute{
+				Description: "Executions matching the filters, newest first",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Execution identifier",
+							Computed:    true,
+						},
+						"workflow_id": schema.StringAttribute{
+							Description: "ID of the executed workflow",
+							Computed:    true,
+						},
+						"mode": schema.StringAttribute{
+							Description: "How the execution was started, e.g. 'trigger', 'webhook' or 'manual'",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the execution",
+							Computed:    true,
+						},
+						"started_at": schema.StringAttribute{
+							Description: "Timestamp when the execution started",
+							Computed:    true,
+						},
+						"stopped_at": schema.StringAttribute{
+							Description: "Timestamp when the execution stopped; empty while it is running",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *executionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ExecutionsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *executionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &executionsDataSource{client: withContext(d.client, ctx)}
+
+	var state executionsDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var since time.Time
+	if !state.Since.IsNull() {
+		var err error
+		since, err = time.Parse(time.RFC3339, state.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("since"),
+				"Invalid Timestamp",
+				"since must be an RFC 3339 timestamp: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	executions, err := d.client.ListExecutions(client.ExecutionFilter{
+		WorkflowID: state.WorkflowID.ValueString(),
+		Status:     state.Status.ValueString(),
+		Limit:      int(state.Limit.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Executions",
+			"Could not list n8n executions: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.Executions = make([]executionModel, 0, len(executions))
+	for _, execution := range executions {
+		if !since.IsZero() {
+			startedAt, err := time.Parse(time.RFC3339, execution.StartedAt)
+			if err != nil || startedAt.Before(since) {
+				continue
+			}
+		}
+
+		state.Executions = append(state.Executions, executionModel{
+			ID:         types.StringValue(execution.ID),
+			WorkflowID: types.StringValue(execution.WorkflowID),
+			Mode:       types.StringValue(execution.Mode),
+			Status:     types.StringValue(execution.Status),
+			StartedAt:  types.StringValue(execution.StartedAt),
+			StoppedAt:  types.StringValue(execution.StoppedAt),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
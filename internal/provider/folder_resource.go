@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &folderResource{}
+	_ resource.ResourceWithConfigure   = &folderResource{}
+	_ resource.ResourceWithImportState = &folderResource{}
+)
+
+// NewFolderResource is a helper function to simplify the provider implementation.
+func NewFolderResource() resource.Resource {
+	return &folderResource{}
+}
+
+// folderResource is the resource implementation.
+type folderResource struct {
+	client client.ProjectsService
+}
+
+// folderResourceModel maps the resource schema data.
+type folderResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Name           types.String `tfsdk:"name"`
+	ParentFolderID types.String `tfsdk:"parent_folder_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *folderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+// Schema defines the schema for the resource.
+func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a folder that organizes workflows within an n8n project. " +
+			"When the folder is destroyed, its workflows and subfolders are moved to its parent folder rather than deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Folder identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project the folder belongs to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the folder",
+				Required:    true,
+			},
+			"parent_folder_id": schema.StringAttribute{
+				Description: "ID of the folder this folder is nested in. If not set, the folder is at the top level of the project.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the folder was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the folder was last updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *folderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ProjectsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &folderResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan folderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.client.CreateFolder(plan.ProjectID.ValueString(), &client.Folder{
+		Name:           plan.Name.ValueString(),
+		ParentFolderID: plan.ParentFolderID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating n8n Folder",
+			"Could not create folder "+plan.Name.ValueString()+" in project "+plan.ProjectID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(folder.ID)
+	plan.CreatedAt = types.StringValue(folder.CreatedAt)
+	plan.UpdatedAt = types.StringValue(folder.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &folderResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state folderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.client.GetFolder(state.ProjectID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		// Check if the folder was deleted outside of Terraform (404 error)
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Folder",
+			"Could not read n8n folder ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(folder.Name)
+	state.ParentFolderID = optionalStringValue(state.ParentFolderID, folder.ParentFolderID)
+	state.CreatedAt = types.StringValue(folder.CreatedAt)
+	state.UpdatedAt = types.StringValue(folder.UpdatedAt)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &folderResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan folderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.client.UpdateFolder(plan.ProjectID.ValueString(), plan.ID.ValueString(), &client.Folder{
+		Name:           plan.Name.ValueString(),
+		ParentFolderID: plan.ParentFolderID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating n8n Folder",
+			"Could not update folder ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.UpdatedAt = types.StringValue(folder.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &folderResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state folderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the contents of the folder by moving them one level up
+	transferToID := client.ProjectRootFolderID
+	if !state.ParentFolderID.IsNull() && state.ParentFolderID.ValueString() != "" {
+		transferToID = state.ParentFolderID.ValueString()
+	}
+
+	err := r.client.DeleteFolder(state.ProjectID.ValueString(), state.ID.ValueString(), transferToID)
+	if err != nil {
+		// The folder may already be gone
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting n8n Folder",
+			"Could not delete folder ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *folderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using project_id:folder_id
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import ID in the form project_id:folder_id, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+}
@@ -0,0 +1,60 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFolderResource(t *testing.T) {
+	projectID := testAccPersonalProjectID(t)
+	config := func(name string) string {
+		return fmt.Sprintf(`
+resource "n8n_folder" "parent" {
+  project_id = %q
+  name       = "tf-acc parent"
+}
+
+resource "n8n_folder" "test" {
+  project_id       = n8n_folder.parent.project_id
+  name             = %q
+  parent_folder_id = n8n_folder.parent.id
+}
+`, projectID, name)
+	}
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("n8n_folder.test", "project_id", projectID),
+		resource.TestCheckResourceAttrSet("n8n_folder.test", "id"),
+		resource.TestCheckResourceAttrPair("n8n_folder.test", "parent_folder_id", "n8n_folder.parent", "id"),
+		resource.TestCheckResourceAttrSet("n8n_folder.test", "created_at"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf-acc folder"),
+				Check:  check,
+			},
+			{
+				Config:           config("tf-acc folder renamed"),
+				ConfigPlanChecks: testAccExpectAction("n8n_folder.test", plancheck.ResourceActionUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					check,
+					resource.TestCheckResourceAttr("n8n_folder.test", "name", "tf-acc folder renamed"),
+				),
+			},
+			{
+				ResourceName:        "n8n_folder.test",
+				ImportState:         true,
+				ImportStateIdPrefix: projectID + ":",
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// resourceIdentityModel maps the identity of resources n8n addresses by ID.
+type resourceIdentityModel struct {
+	ID       types.String `tfsdk:"id"`
+	Endpoint types.String `tfsdk:"endpoint"`
+}
+
+// resourceIdentitySchema returns the identity schema of resources n8n
+// addresses by ID. IDs are only unique within an instance, so the endpoint
+// of the instance is part of the identity.
+func resourceIdentitySchema(idDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       idDescription,
+				RequiredForImport: true,
+			},
+			"endpoint": identityschema.StringAttribute{
+				Description:       "n8n API endpoint of the instance the resource belongs to. Defaults to the endpoint of the provider when importing.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// setResourceIdentity records the identity of the resource with the given
+// ID, unless it is already known. An identity never changes once it is
+// stored, even when the provider endpoint is changed to another URL of the
+// same instance.
+func setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, c client.Endpoints, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	var current resourceIdentityModel
+	diags := identity.Get(ctx, &current)
+	if diags.HasError() {
+		return diags
+	}
+	if !current.ID.IsNull() && !current.Endpoint.IsNull() {
+		return diags
+	}
+
+	diags.Append(identity.Set(ctx, resourceIdentityModel{
+		ID:       types.StringValue(id),
+		Endpoint: types.StringValue(c.APIEndpoint()),
+	})...)
+	return diags
+}
+
+// importedResourceID returns the ID of the resource to import, taken from
+// either the import ID or the identity of an import block. An identity
+// endpoint must match the endpoint of the provider.
+func importedResourceID(ctx context.Context, req resource.ImportStateRequest, c client.Endpoints) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, diags
+	}
+
+	var identity resourceIdentityModel
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if endpoint := strings.TrimSuffix(identity.Endpoint.ValueString(), "/"); endpoint != "" && c != nil && endpoint != c.APIEndpoint() {
+		diags.AddAttributeError(
+			path.Root("endpoint"),
+			"Mismatched n8n Endpoint",
+			"The resource identity belongs to the n8n instance at "+endpoint+", but the provider is configured for "+c.APIEndpoint()+". "+
+				"Import the resource with a provider configured for that instance.",
+		)
+		return "", diags
+	}
+
+	return identity.ID.ValueString(), diags
+}
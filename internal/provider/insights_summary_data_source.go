@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &insightsSummaryDataSource{}
+	_ datasource.DataSourceWithConfigure = &insightsSummaryDataSource{}
+)
+
+// NewInsightsSummaryDataSource is a helper function to simplify the provider implementation.
+func NewInsightsSummaryDataSource() datasource.DataSource {
+	return &insightsSummaryDataSource{}
+}
+
+// insightsSummaryDataSource is the data source implementation.
+type insightsSummaryDataSource struct {
+	client client.InsightsService
+}
+
+// insightsSummaryDataSourceModel maps the data source schema data.
+type insightsSummaryDataSourceModel struct {
+	StartDate        types.String  `tfsdk:"start_date"`
+	EndDate          types.String  `tfsdk:"end_date"`
+	ProjectID        types.String  `tfsdk:"project_id"`
+	TotalExecutions  types.Int64   `tfsdk:"total_executions"`
+	FailedExecutions types.Int64   `tfsdk:"failed_executions"`
+	FailureRate      types.Float64 `tfsdk:"failure_rate"`
+	TimeSavedMinutes types.Float64 `tfsdk:"time_saved_minutes"`
+	AverageRunTimeMs types.Float64 `tfsdk:"average_run_time_ms"`
+}
+
+// Metadata returns the data source type name.
+func (d *insightsSummaryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_insights_summary"
+}
+
+// Schema defines the schema for the data source.
+func (d *insightsSummaryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches n8n instance insights (execution counts, failure rate, run time) for a time range.",
+		Attributes: map[string]schema.Attribute{
+			"start_date": schema.StringAttribute{
+				Description: "Start of the time range as an RFC 3339 timestamp (e.g., '2025-01-01T00:00:00Z')",
+				Required:    true,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "End of the time range as an RFC 3339 timestamp (e.g., '2025-01-08T00:00:00Z')",
+				Required:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Restrict the insights to a single project. Defaults to the whole instance.",
+				Optional:    true,
+			},
+			"total_executions": schema.Int64Attribute{
+				Description: "Number of production executions in the time range",
+				Computed:    true,
+			},
+			"failed_executions": schema.Int64Attribute{
+				Description: "Number of failed production executions in the time range",
+				Computed:    true,
+			},
+			"failure_rate": schema.Float64Attribute{
+				Description: "Ratio of failed executions to total executions (0 to 1)",
+				Computed:    true,
+			},
+			"time_saved_minutes": schema.Float64Attribute{
+				Description: "Estimated time saved by the workflows, in minutes",
+				Computed:    true,
+			},
+			"average_run_time_ms": schema.Float64Attribute{
+				Description: "Average execution run time, in milliseconds",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *insightsSummaryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.InsightsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *insightsSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &insightsSummaryDataSource{client: withContext(d.client, ctx)}
+
+	var state insightsSummaryDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate the time range before calling the API
+	startDate, err := time.Parse(time.RFC3339, state.StartDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_date"),
+			"Invalid start_date",
+			"start_date must be an RFC 3339 timestamp: "+err.Error(),
+		)
+	}
+	endDate, err := time.Parse(time.RFC3339, state.EndDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid end_date",
+			"end_date must be an RFC 3339 timestamp: "+err.Error(),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !endDate.After(startDate) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid time range",
+			"end_date must be after start_date",
+		)
+		return
+	}
+
+	// Get insights from n8n
+	summary, err := d.client.GetInsightsSummary(
+		startDate.UTC().Format(time.RFC3339),
+		endDate.UTC().Format(time.RFC3339),
+		state.ProjectID.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Insights",
+			"Could not read n8n insights summary: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.TotalExecutions = types.Int64Value(int64(summary.Total.Value))
+	state.FailedExecutions = types.Int64Value(int64(summary.Failed.Value))
+	state.FailureRate = types.Float64Value(summary.FailureRate.Value)
+	state.TimeSavedMinutes = types.Float64Value(summary.TimeSaved.Value)
+	state.AverageRunTimeMs = types.Float64Value(summary.AverageRunTime.Value)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
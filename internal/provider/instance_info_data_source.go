@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &instanceInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &instanceInfoDataSource{}
+)
+
+// NewInstanceInfoDataSource is a helper function to simplify the provider implementation.
+func NewInstanceInfoDataSource() datasource.DataSource {
+	return &instanceInfoDataSource{}
+}
+
+// instanceInfoDataSource is the data source implementation.
+type instanceInfoDataSource struct {
+	client client.InstanceService
+}
+
+// instanceInfoDataSourceModel maps the data source schema data.
+type instanceInfoDataSourceModel struct {
+	MinVersion types.String `tfsdk:"min_version"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	Version    types.String `tfsdk:"version"`
+	EditorURL  types.String `tfsdk:"editor_url"`
+	Features   types.Object `tfsdk:"features"`
+}
+
+// instanceFeatureAttrTypes are the attribute types of the features object.
+var instanceFeatureAttrTypes = map[string]attr.Type{
+	"projects":         types.BoolType,
+	"sharing":          types.BoolType,
+	"source_control":   types.BoolType,
+	"ldap":             types.BoolType,
+	"saml":             types.BoolType,
+	"log_streaming":    types.BoolType,
+	"variables":        types.BoolType,
+	"external_secrets": types.BoolType,
+}
+
+// Metadata returns the data source type name.
+func (d *instanceInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *instanceInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	featureAttributes := make(map[string]schema.Attribute, len(instanceFeatureAttrTypes))
+	for name := range instanceFeatureAttrTypes {
+		featureAttributes[name] = schema.BoolAttribute{
+			Description: "Whether the " + strings.ReplaceAll(name, "_", " ") + " feature is licensed",
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reports the version, URL and enabled enterprise features of the n8n instance.",
+		Attributes: map[string]schema.Attribute{
+			"min_version": schema.StringAttribute{
+				Description: "Fail if the instance runs an older n8n version than this, e.g. '1.80.0'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+(\.\d+)*$`), "must be a version such as '1.80.0'"),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "Unique identifier of the instance",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of n8n running on the instance",
+				Computed:    true,
+			},
+			"editor_url": schema.StringAttribute{
+				Description: "Base URL of the n8n editor",
+				Computed:    true,
+			},
+			"features": schema.SingleNestedAttribute{
+				Description: "Enterprise features that are licensed on the instance",
+				Computed:    true,
+				Attributes:  featureAttributes,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *instanceInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.InstanceService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *instanceInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &instanceInfoDataSource{client: withContext(d.client, ctx)}
+
+	var state instanceInfoDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.GetInstanceInfo()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Instance Info",
+			"Could not read instance info: "+err.Error(),
+		)
+		return
+	}
+
+	if minVersion := state.MinVersion.ValueString(); minVersion != "" && compareVersions(info.Version, minVersion) < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_version"),
+			"Unsupported n8n Version",
+			fmt.Sprintf("The n8n instance runs version %s, but at least version %s is required.", info.Version, minVersion),
+		)
+		return
+	}
+
+	// Map response to state
+	state.InstanceID = types.StringValue(info.InstanceID)
+	state.Version = types.StringValue(info.Version)
+	state.EditorURL = types.StringValue(info.EditorURL)
+	state.Features, diags = types.ObjectValue(instanceFeatureAttrTypes, map[string]attr.Value{
+		"projects":         types.BoolValue(info.Enterprise.TeamProjects()),
+		"sharing":          types.BoolValue(info.Enterprise.Sharing),
+		"source_control":   types.BoolValue(info.Enterprise.SourceControl),
+		"ldap":             types.BoolValue(info.Enterprise.LDAP),
+		"saml":             types.BoolValue(info.Enterprise.SAML),
+		"log_streaming":    types.BoolValue(info.Enterprise.LogStreaming),
+		"variables":        types.BoolValue(info.Enterprise.Variables),
+		"external_secrets": types.BoolValue(info.Enterprise.ExternalSecrets),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// compareVersions compares two dotted version numbers such as "1.80.0",
+// ignoring pre-release suffixes, and returns -1, 0 or 1.
+func compareVersions(a, b string) int {
+	as := strings.Split(strings.SplitN(a, "-", 2)[0], ".")
+	bs := strings.Split(strings.SplitN(b, "-", 2)[0], ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// instanceSettingsID is the fixed identifier of the instance settings.
+const instanceSettingsID = "instance-settings"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &instanceSettingsResource{}
+	_ resource.ResourceWithConfigure   = &instanceSettingsResource{}
+	_ resource.ResourceWithImportState = &instanceSettingsResource{}
+)
+
+// NewInstanceSettingsResource is a helper function to simplify the provider implementation.
+func NewInstanceSettingsResource() resource.Resource {
+	return &instanceSettingsResource{}
+}
+
+// instanceSettingsResource is the resource implementation.
+type instanceSettingsResource struct {
+	client client.InstanceService
+}
+
+// instanceSettingsResourceModel maps the resource schema data.
+type instanceSettingsResourceModel struct {
+	ID                           types.String `tfsdk:"id"`
+	Timezone                     types.String `tfsdk:"timezone"`
+	TelemetryEnabled             types.Bool   `tfsdk:"telemetry_enabled"`
+	PersonalizationSurveyEnabled types.Bool   `tfsdk:"personalization_survey_enabled"`
+	DismissedBanners             types.Set    `tfsdk:"dismissed_banners"`
+	Version                      types.String `tfsdk:"version"`
+}
+
+// Metadata returns the resource type name.
+func (r *instanceSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *instanceSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages instance-level n8n settings that can be changed through the API. There is only one set of settings per instance; " +
+			"only the attributes set here are managed, and destroying the resource leaves the settings as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier (always 'instance-settings')",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "Default timezone of the instance, e.g. 'Europe/Berlin'",
+				Optional:    true,
+			},
+			"telemetry_enabled": schema.BoolAttribute{
+				Description: "Whether anonymous usage data is sent to n8n",
+				Optional:    true,
+			},
+			"personalization_survey_enabled": schema.BoolAttribute{
+				Description: "Whether new users are asked to fill in the personalization survey",
+				Optional:    true,
+			},
+			"dismissed_banners": schema.SetAttribute{
+				Description: "Banners that are no longer shown, e.g. 'V1' or 'NON_PRODUCTION_LICENSE'",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of n8n running on the instance",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *instanceSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.InstanceService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *instanceSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &instanceSettingsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan instanceSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(instanceSettingsID)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *instanceSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &instanceSettingsResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state instanceSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetInstanceSettings()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Instance Settings",
+			"Could not read instance settings: "+err.Error(),
+		)
+		return
+	}
+
+	// Only refresh the settings that are managed, or all of them after an
+	// import, when the version is not known yet
+	imported := state.Version.IsNull()
+	state.ID = types.StringValue(instanceSettingsID)
+	if !state.Timezone.IsNull() || imported {
+		state.Timezone = types.StringValue(settings.Timezone)
+	}
+	if (!state.TelemetryEnabled.IsNull() || imported) && settings.TelemetryEnabled != nil {
+		state.TelemetryEnabled = types.BoolValue(*settings.TelemetryEnabled)
+	}
+	if (!state.PersonalizationSurveyEnabled.IsNull() || imported) && settings.PersonalizationSurveyEnabled != nil {
+		state.PersonalizationSurveyEnabled = types.BoolValue(*settings.PersonalizationSurveyEnabled)
+	}
+	if !state.DismissedBanners.IsNull() || imported {
+		state.DismissedBanners, diags = types.SetValueFrom(ctx, types.StringType, settings.DismissedBanners)
+		resp.Diagnostics.Append(diags...)
+	}
+	state.Version = types.StringValue(settings.Version)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *instanceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &instanceSettingsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan instanceSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state only.
+func (r *instanceSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The settings stay as they are; there are no "unset" values to return to
+}
+
+// ImportState imports the resource state.
+func (r *instanceSettingsResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is only one set of settings per instance, so any import ID will do
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), instanceSettingsID)...)
+}
+
+// update sends the configured settings to n8n and records the reported version.
+func (r *instanceSettingsResource) update(ctx context.Context, plan *instanceSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	settings := &client.InstanceSettings{
+		Timezone:                     plan.Timezone.ValueString(),
+		TelemetryEnabled:             plan.TelemetryEnabled.ValueBoolPointer(),
+		PersonalizationSurveyEnabled: plan.PersonalizationSurveyEnabled.ValueBoolPointer(),
+	}
+	if !plan.DismissedBanners.IsNull() {
+		diags.Append(plan.DismissedBanners.ElementsAs(ctx, &settings.DismissedBanners, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	updated, err := r.client.UpdateInstanceSettings(settings)
+	if err != nil {
+		diags.AddError(
+			"Error Updating n8n Instance Settings",
+			"Could not update instance settings: "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.Version = types.StringValue(updated.Version)
+	return diags
+}
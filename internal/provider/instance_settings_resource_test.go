@@ -0,0 +1,45 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccInstanceSettingsResource(t *testing.T) {
+	config := func(timezone string) string {
+		return fmt.Sprintf(`
+resource "n8n_instance_settings" "test" {
+  timezone                       = %q
+  telemetry_enabled              = false
+  personalization_survey_enabled = false
+  dismissed_banners              = ["V1"]
+}
+`, timezone)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Europe/Berlin"),
+				Check:  resource.TestCheckResourceAttrSet("n8n_instance_settings.test", "version"),
+			},
+			{
+				Config:           config("America/New_York"),
+				ConfigPlanChecks: testAccExpectAction("n8n_instance_settings.test", plancheck.ResourceActionUpdate),
+				Check:            resource.TestCheckResourceAttr("n8n_instance_settings.test", "timezone", "America/New_York"),
+			},
+			{
+				ResourceName:      "n8n_instance_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// licenseID is the fixed identifier of the instance license.
+const licenseID = "license"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &licenseResource{}
+	_ resource.ResourceWithConfigure   = &licenseResource{}
+	_ resource.ResourceWithImportState = &licenseResource{}
+)
+
+// NewLicenseResource is a helper function to simplify the provider implementation.
+func NewLicenseResource() resource.Resource {
+	return &licenseResource{}
+}
+
+// licenseResource is the resource implementation.
+type licenseResource struct {
+	client client.LicenseService
+}
+
+// licenseResourceModel maps the resource schema data.
+type licenseResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	ActivationKey               types.String `tfsdk:"activation_key"`
+	PlanID                      types.String `tfsdk:"plan_id"`
+	PlanName                    types.String `tfsdk:"plan_name"`
+	Features                    types.Set    `tfsdk:"features"`
+	ActiveWorkflowTriggers      types.Int64  `tfsdk:"active_workflow_triggers"`
+	ActiveWorkflowTriggersLimit types.Int64  `tfsdk:"active_workflow_triggers_limit"`
+}
+
+// Metadata returns the resource type name.
+func (r *licenseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license"
+}
+
+// Schema defines the schema for the resource.
+func (r *licenseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Activates an n8n enterprise license key and exposes the licensed entitlements. " +
+			"There is only one license per instance; destroying the resource leaves the license active.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier (always 'license')",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"activation_key": schema.StringAttribute{
+				Description: "The license activation key. Changing the key activates the new key.",
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plan_id": schema.StringAttribute{
+				Description: "ID of the licensed plan",
+				Computed:    true,
+			},
+			"plan_name": schema.StringAttribute{
+				Description: "Name of the licensed plan, e.g. 'Enterprise'",
+				Computed:    true,
+			},
+			"features": schema.SetAttribute{
+				Description: "Features enabled by the license, e.g. 'feat:sharing' or 'feat:saml'",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"active_workflow_triggers": schema.Int64Attribute{
+				Description: "Number of active workflow triggers counted against the license",
+				Computed:    true,
+			},
+			"active_workflow_triggers_limit": schema.Int64Attribute{
+				Description: "Maximum number of active workflow triggers, or -1 for unlimited",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *licenseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LicenseService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &licenseResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan licenseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	license, err := r.client.ActivateLicense(plan.ActivationKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Activating n8n License",
+			"Could not activate license: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(licenseID)
+	resp.Diagnostics.Append(setLicenseComputed(ctx, &plan, license)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *licenseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &licenseResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state licenseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	license, err := r.client.GetLicense()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n License",
+			"Could not read license: "+err.Error(),
+		)
+		return
+	}
+
+	// The activation key cannot be read back, so it is kept from state
+	state.ID = types.StringValue(licenseID)
+	resp.Diagnostics.Append(setLicenseComputed(ctx, &state, license)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *licenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The activation key requires replacement, so there is nothing to update
+	var plan licenseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state only.
+func (r *licenseResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The n8n API cannot deactivate a license, so it stays active
+}
+
+// ImportState imports the resource state.
+func (r *licenseResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is only one license per instance, so any import ID will do
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), licenseID)...)
+}
+
+// setLicenseComputed copies the licensed entitlements into the model.
+func setLicenseComputed(ctx context.Context, model *licenseResourceModel, license *client.License) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.PlanID = types.StringValue(license.License.PlanID)
+	model.PlanName = types.StringValue(license.License.PlanName)
+	model.ActiveWorkflowTriggers = types.Int64Value(license.Usage.ActiveWorkflowTriggers.Value)
+	model.ActiveWorkflowTriggersLimit = types.Int64Value(license.Usage.ActiveWorkflowTriggers.Limit)
+
+	features := license.License.Features
+	if features == nil {
+		features = []string{}
+	}
+	model.Features, diags = types.SetValueFrom(ctx, types.StringType, features)
+
+	return diags
+}
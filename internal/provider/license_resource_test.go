@@ -0,0 +1,36 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLicenseResource(t *testing.T) {
+	activationKey := testAccRequireEnv(t, "N8N_LICENSE_ACTIVATION_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "n8n_license" "test" {
+  activation_key = %q
+}
+`, activationKey),
+				Check: resource.TestCheckResourceAttrSet("n8n_license.test", "plan_name"),
+			},
+			{
+				ResourceName:      "n8n_license.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The activation key cannot be read back
+				ImportStateVerifyIgnore: []string{"activation_key"},
+			},
+		},
+	})
+}
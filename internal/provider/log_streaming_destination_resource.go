@@ -0,0 +1,468 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &logStreamingDestinationResource{}
+	_ resource.ResourceWithConfigure   = &logStreamingDestinationResource{}
+	_ resource.ResourceWithImportState = &logStreamingDestinationResource{}
+)
+
+// NewLogStreamingDestinationResource is a helper function to simplify the provider implementation.
+func NewLogStreamingDestinationResource() resource.Resource {
+	return &logStreamingDestinationResource{}
+}
+
+// logStreamingDestinationResource is the resource implementation.
+type logStreamingDestinationResource struct {
+	client client.LogStreamingService
+}
+
+// logStreamingDestinationResourceModel maps the resource schema data.
+type logStreamingDestinationResourceModel struct {
+	ID                     types.String              `tfsdk:"id"`
+	Label                  types.String              `tfsdk:"label"`
+	Enabled                types.Bool                `tfsdk:"enabled"`
+	SubscribedEvents       types.List                `tfsdk:"subscribed_events"`
+	AnonymizeAuditMessages types.Bool                `tfsdk:"anonymize_audit_messages"`
+	Webhook                *logStreamingWebhookModel `tfsdk:"webhook"`
+	Syslog                 *logStreamingSyslogModel  `tfsdk:"syslog"`
+	Sentry                 *logStreamingSentryModel  `tfsdk:"sentry"`
+}
+
+// logStreamingWebhookModel maps the webhook attribute.
+type logStreamingWebhookModel struct {
+	URL     types.String `tfsdk:"url"`
+	Method  types.String `tfsdk:"method"`
+	Headers types.Map    `tfsdk:"headers"`
+}
+
+// logStreamingSyslogModel maps the syslog attribute.
+type logStreamingSyslogModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+	Facility types.Int64  `tfsdk:"facility"`
+	AppName  types.String `tfsdk:"app_name"`
+}
+
+// logStreamingSentryModel maps the sentry attribute.
+type logStreamingSentryModel struct {
+	DSN types.String `tfsdk:"dsn"`
+}
+
+// Metadata returns the resource type name.
+func (r *logStreamingDestinationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_streaming_destination"
+}
+
+// Schema defines the schema for the resource.
+func (r *logStreamingDestinationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	destinationTypes := []path.Expression{
+		path.MatchRoot("webhook"),
+		path.MatchRoot("syslog"),
+		path.MatchRoot("sentry"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an n8n log streaming (event bus) destination. " +
+			"Exactly one of webhook, syslog or sentry must be set. Requires the n8n enterprise log streaming feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Destination identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Display name of the destination",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether events are sent to the destination. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"subscribed_events": schema.ListAttribute{
+				Description: "Events or event groups sent to the destination, e.g. 'n8n.audit' or 'n8n.workflow.failed'",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"anonymize_audit_messages": schema.BoolAttribute{
+				Description: "Whether personal data is removed from audit events. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"webhook": schema.SingleNestedAttribute{
+				Description: "Send events to an HTTP endpoint",
+				Optional:    true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(destinationTypes...),
+				},
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "URL events are sent to",
+						Required:    true,
+					},
+					"method": schema.StringAttribute{
+						Description: "HTTP method. Defaults to POST.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("POST"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("GET", "POST", "PUT"),
+						},
+					},
+					"headers": schema.MapAttribute{
+						Description: "Additional headers sent with each request",
+						Optional:    true,
+						Sensitive:   true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"syslog": schema.SingleNestedAttribute{
+				Description: "Send events to a syslog server",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						Description: "Syslog server host",
+						Required:    true,
+					},
+					"port": schema.Int64Attribute{
+						Description: "Syslog server port. Defaults to 514.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(514),
+					},
+					"protocol": schema.StringAttribute{
+						Description: "Transport protocol, 'udp' or 'tcp'. Defaults to udp.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("udp"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("udp", "tcp"),
+						},
+					},
+					"facility": schema.Int64Attribute{
+						Description: "Syslog facility code. Defaults to 16 (local0).",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(16),
+					},
+					"app_name": schema.StringAttribute{
+						Description: "Application name sent with each message. Defaults to n8n.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("n8n"),
+					},
+				},
+			},
+			"sentry": schema.SingleNestedAttribute{
+				Description: "Send events to Sentry",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"dsn": schema.StringAttribute{
+						Description: "Sentry DSN",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *logStreamingDestinationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LogStreamingService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *logStreamingDestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &logStreamingDestinationResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan logStreamingDestinationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destination, diags := logStreamingDestinationFromModel(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.SaveLogStreamingDestination(destination)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating n8n Log Streaming Destination",
+			"Could not create log streaming destination: "+err.Error()+licenseHint(err, "log streaming"),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *logStreamingDestinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &logStreamingDestinationResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state logStreamingDestinationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destination, err := r.client.GetLogStreamingDestination(state.ID.ValueString())
+	if err != nil {
+		// If the destination doesn't exist, remove from state
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Log Streaming Destination",
+			"Could not read log streaming destination ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenLogStreamingDestination(destination, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *logStreamingDestinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &logStreamingDestinationResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan logStreamingDestinationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destination, diags := logStreamingDestinationFromModel(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Saving with an existing ID replaces the destination in place
+	destination.ID = plan.ID.ValueString()
+	if _, err := r.client.SaveLogStreamingDestination(destination); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating n8n Log Streaming Destination",
+			"Could not update log streaming destination, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *logStreamingDestinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &logStreamingDestinationResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state logStreamingDestinationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLogStreamingDestination(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting n8n Log Streaming Destination",
+			"Could not delete log streaming destination, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *logStreamingDestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// logStreamingDestinationFromModel builds the API payload from the resource model.
+func logStreamingDestinationFromModel(ctx context.Context, model *logStreamingDestinationResourceModel) (*client.LogStreamingDestination, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	destination := &client.LogStreamingDestination{
+		Label:                  model.Label.ValueString(),
+		Enabled:                model.Enabled.ValueBool(),
+		AnonymizeAuditMessages: model.AnonymizeAuditMessages.ValueBool(),
+	}
+	diags.Append(model.SubscribedEvents.ElementsAs(ctx, &destination.SubscribedEvents, false)...)
+
+	switch {
+	case model.Webhook != nil:
+		destination.Type = client.LogStreamingTypeWebhook
+		destination.URL = model.Webhook.URL.ValueString()
+		destination.Method = model.Webhook.Method.ValueString()
+		if !model.Webhook.Headers.IsNull() {
+			var headers map[string]string
+			diags.Append(model.Webhook.Headers.ElementsAs(ctx, &headers, false)...)
+
+			// Sort for a stable payload
+			names := make([]string, 0, len(headers))
+			for name := range headers {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			params := &client.LogStreamingHeaderParameters{}
+			for _, name := range names {
+				params.Parameters = append(params.Parameters, client.LogStreamingHeader{Name: name, Value: headers[name]})
+			}
+			destination.SendHeaders = len(names) > 0
+			destination.HeaderParameters = params
+		}
+	case model.Syslog != nil:
+		destination.Type = client.LogStreamingTypeSyslog
+		destination.Host = model.Syslog.Host.ValueString()
+		destination.Port = model.Syslog.Port.ValueInt64()
+		destination.Protocol = model.Syslog.Protocol.ValueString()
+		destination.Facility = model.Syslog.Facility.ValueInt64()
+		destination.AppName = model.Syslog.AppName.ValueString()
+	case model.Sentry != nil:
+		destination.Type = client.LogStreamingTypeSentry
+		destination.DSN = model.Sentry.DSN.ValueString()
+	}
+
+	return destination, diags
+}
+
+// flattenLogStreamingDestination refreshes the resource model from the API response.
+func flattenLogStreamingDestination(destination *client.LogStreamingDestination, model *logStreamingDestinationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(destination.ID)
+	model.Label = types.StringValue(destination.Label)
+	model.Enabled = types.BoolValue(destination.Enabled)
+	model.AnonymizeAuditMessages = types.BoolValue(destination.AnonymizeAuditMessages)
+
+	events := make([]attr.Value, 0, len(destination.SubscribedEvents))
+	for _, event := range destination.SubscribedEvents {
+		events = append(events, types.StringValue(event))
+	}
+	list, d := types.ListValue(types.StringType, events)
+	diags.Append(d...)
+	model.SubscribedEvents = list
+
+	model.Webhook, model.Syslog, model.Sentry = nil, nil, nil
+	switch destination.Type {
+	case client.LogStreamingTypeWebhook:
+		webhook := &logStreamingWebhookModel{
+			URL:     types.StringValue(destination.URL),
+			Method:  types.StringValue(destination.Method),
+			Headers: types.MapNull(types.StringType),
+		}
+		if destination.SendHeaders && destination.HeaderParameters != nil && len(destination.HeaderParameters.Parameters) > 0 {
+			headers := make(map[string]attr.Value, len(destination.HeaderParameters.Parameters))
+			for _, header := range destination.HeaderParameters.Parameters {
+				headers[header.Name] = types.StringValue(header.Value)
+			}
+			m, d := types.MapValue(types.StringType, headers)
+			diags.Append(d...)
+			webhook.Headers = m
+		}
+		model.Webhook = webhook
+	case client.LogStreamingTypeSyslog:
+		model.Syslog = &logStreamingSyslogModel{
+			Host:     types.StringValue(destination.Host),
+			Port:     types.Int64Value(destination.Port),
+			Protocol: types.StringValue(destination.Protocol),
+			Facility: types.Int64Value(destination.Facility),
+			AppName:  types.StringValue(destination.AppName),
+		}
+	case client.LogStreamingTypeSentry:
+		model.Sentry = &logStreamingSentryModel{
+			DSN: types.StringValue(destination.DSN),
+		}
+	default:
+		diags.AddError(
+			"Unsupported Log Streaming Destination",
+			fmt.Sprintf("Destination %s has unsupported type %q", destination.ID, destination.Type),
+		)
+	}
+
+	return diags
+}
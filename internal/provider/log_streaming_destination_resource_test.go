@@ -0,0 +1,50 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+func TestAccLogStreamingDestinationResource(t *testing.T) {
+	testAccRequireFeature(t, "log streaming", func(f *client.EnterpriseFeatures) bool { return f.LogStreaming })
+	config := func(label string) string {
+		return fmt.Sprintf(`
+resource "n8n_log_streaming_destination" "test" {
+  label             = %q
+  subscribed_events = ["n8n.audit"]
+
+  webhook = {
+    url = "https://example.com/n8n"
+  }
+}
+`, label)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf-acc destination"),
+				Check:  resource.TestCheckResourceAttrSet("n8n_log_streaming_destination.test", "id"),
+			},
+			{
+				Config:           config("tf-acc destination renamed"),
+				ConfigPlanChecks: testAccExpectAction("n8n_log_streaming_destination.test", plancheck.ResourceActionUpdate),
+				Check:            resource.TestCheckResourceAttr("n8n_log_streaming_destination.test", "label", "tf-acc destination renamed"),
+			},
+			{
+				ResourceName:      "n8n_log_streaming_destination.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = normalizedJSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = normalizedJSONValue{}
+	_ xattr.ValidateableAttribute                = normalizedJSONValue{}
+)
+
+// normalizedJSONType is a string attribute type holding JSON. Values that
+// decode to the same JSON document are semantically equal, so key order and
+// whitespace do not show up as changes.
+type normalizedJSONType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable name of the type.
+func (t normalizedJSONType) String() string {
+	return "normalizedJSONType"
+}
+
+// ValueType returns the value type of the type.
+func (t normalizedJSONType) ValueType(_ context.Context) attr.Value {
+	return normalizedJSONValue{}
+}
+
+// Equal reports whether the given type is a normalizedJSONType.
+func (t normalizedJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(normalizedJSONType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString wraps a string value.
+func (t normalizedJSONType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return normalizedJSONValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value.
+func (t normalizedJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return normalizedJSONValue{StringValue: stringValue}, nil
+}
+
+// normalizedJSONValue is a value of normalizedJSONType.
+type normalizedJSONValue struct {
+	basetypes.StringValue
+}
+
+// normalizedJSONString returns a known value holding the given JSON.
+func normalizedJSONString(value string) normalizedJSONValue {
+	return normalizedJSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// normalizedJSONNull returns a null value.
+func normalizedJSONNull() normalizedJSONValue {
+	return normalizedJSONValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns the type of the value.
+func (v normalizedJSONValue) Type(_ context.Context) attr.Type {
+	return normalizedJSONType{}
+}
+
+// Equal reports whether the given value is exactly equal, including formatting.
+func (v normalizedJSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(normalizedJSONValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values decode to the same JSON document.
+func (v normalizedJSONValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(normalizedJSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	var current, proposed interface{}
+	if err := json.Unmarshal([]byte(v.ValueString()), &current); err != nil {
+		return false, diags
+	}
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &proposed); err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(current, proposed), diags
+}
+
+// equalsJSON reports whether a known value decodes to the same JSON document
+// as other encodes to.
+func (v normalizedJSONValue) equalsJSON(other interface{}) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	otherJSON, err := json.Marshal(other)
+	if err != nil {
+		return false
+	}
+
+	var current, proposed interface{}
+	if err := json.Unmarshal([]byte(v.ValueString()), &current); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(otherJSON, &proposed); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(current, proposed)
+}
+
+// ValidateAttribute checks that a known value is valid JSON.
+func (v normalizedJSONValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON.",
+		)
+	}
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestNormalizedJSONSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		current  string
+		proposed string
+		want     bool
+	}{
+		"identical": {
+			current:  `{"name":"Orders"}`,
+			proposed: `{"name":"Orders"}`,
+			want:     true,
+		},
+		"key order": {
+			current:  `{"name":"Orders","active":false}`,
+			proposed: `{"active":false,"name":"Orders"}`,
+			want:     true,
+		},
+		"whitespace": {
+			current:  `[{"name":"Start","position":[0,0]}]`,
+			proposed: "[\n  {\n    \"name\": \"Start\",\n    \"position\": [0, 0]\n  }\n]",
+			want:     true,
+		},
+		"number formatting": {
+			current:  `{"typeVersion":1}`,
+			proposed: `{"typeVersion":1.0}`,
+			want:     true,
+		},
+		"different value": {
+			current:  `{"name":"Orders"}`,
+			proposed: `{"name":"Invoices"}`,
+		},
+		"array order": {
+			current:  `[{"name":"A"},{"name":"B"}]`,
+			proposed: `[{"name":"B"},{"name":"A"}]`,
+		},
+		"extra key": {
+			current:  `{"name":"Orders"}`,
+			proposed: `{"name":"Orders","active":true}`,
+		},
+		"invalid current": {
+			current:  `{"name":`,
+			proposed: `{"name":"Orders"}`,
+		},
+		"invalid proposed": {
+			current:  `{"name":"Orders"}`,
+			proposed: `not json`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := normalizedJSONString(tt.current).StringSemanticEquals(context.Background(), normalizedJSONString(tt.proposed))
+			if diags.HasError() {
+				t.Fatalf("StringSemanticEquals() diagnostics = %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizedJSONSemanticEqualsOtherType(t *testing.T) {
+	_, diags := normalizedJSONString(`{}`).StringSemanticEquals(context.Background(), basetypes.NewStringValue(`{}`))
+	if !diags.HasError() {
+		t.Error("StringSemanticEquals() accepted a plain string value")
+	}
+}
+
+func TestNormalizedJSONValidateAttribute(t *testing.T) {
+	tests := map[string]struct {
+		value   normalizedJSONValue
+		wantErr bool
+	}{
+		"object":  {value: normalizedJSONString(`{"name":"Orders"}`)},
+		"array":   {value: normalizedJSONString(`[]`)},
+		"null":    {value: normalizedJSONNull()},
+		"unknown": {value: normalizedJSONValue{StringValue: basetypes.NewStringUnknown()}},
+		"invalid": {value: normalizedJSONString(`{"name":`), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var resp xattr.ValidateAttributeResponse
+			tt.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("nodes")}, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ValidateAttribute() error = %t, want %t: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &ownerSetupResource{}
+	_ resource.ResourceWithConfigure = &ownerSetupResource{}
+)
+
+// NewOwnerSetupResource is a helper function to simplify the provider implementation.
+func NewOwnerSetupResource() resource.Resource {
+	return &ownerSetupResource{}
+}
+
+// ownerSetupResource is the resource implementation.
+type ownerSetupResource struct {
+	client client.OwnerService
+}
+
+// ownerSetupResourceModel maps the resource schema data.
+type ownerSetupResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Email        types.String `tfsdk:"email"`
+	FirstName    types.String `tfsdk:"first_name"`
+	LastName     types.String `tfsdk:"last_name"`
+	Password     types.String `tfsdk:"password"`
+	APIKeyLabel  types.String `tfsdk:"api_key_label"`
+	APIKeyScopes types.Set    `tfsdk:"api_key_scopes"`
+	APIKey       types.String `tfsdk:"api_key"`
+}
+
+// Metadata returns the resource type name.
+func (r *ownerSetupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_owner_setup"
+}
+
+// Schema defines the schema for the resource.
+func (r *ownerSetupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates the owner account of a freshly installed n8n instance and, optionally, an API key for it. " +
+			"This works without a provider api_key, so a separate provider configuration can use the generated key for everything else. " +
+			"Setup happens only once: later changes to the attributes are not applied to the instance, and destroying the resource leaves the owner in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Owner user identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the owner",
+				Required:    true,
+			},
+			"first_name": schema.StringAttribute{
+				Description: "First name of the owner",
+				Required:    true,
+			},
+			"last_name": schema.StringAttribute{
+				Description: "Last name of the owner",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the owner. n8n requires at least 8 characters including a number and an uppercase letter.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"api_key_label": schema.StringAttribute{
+				Description: "If set, an API key with this label is created for the owner during setup",
+				Optional:    true,
+			},
+			"api_key_scopes": schema.SetAttribute{
+				Description: "Scopes of the API key created during setup. Defaults to all scopes available to the owner.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "The API key created during setup, or empty if api_key_label is not set or the key could not be created",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ownerSetupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.OwnerService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ownerSetupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &ownerSetupResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan ownerSetupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert the scopes before the setup, which cannot be repeated
+	var scopes []string
+	if !plan.APIKeyScopes.IsNull() {
+		resp.Diagnostics.Append(plan.APIKeyScopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	session, err := r.client.SetupOwner(&client.OwnerSetupRequest{
+		Email:     plan.Email.ValueString(),
+		FirstName: plan.FirstName.ValueString(),
+		LastName:  plan.LastName.ValueString(),
+		Password:  plan.Password.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Up n8n Owner",
+			"Could not set up the instance owner (the instance may already be set up): "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(session.User.ID)
+	plan.APIKey = types.StringValue("")
+
+	if !plan.APIKeyLabel.IsNull() {
+		key, err := r.client.CreateSessionAPIKey(session, &client.CreateAPIKeyRequest{
+			Label:  plan.APIKeyLabel.ValueString(),
+			Scopes: scopes,
+		})
+		if err != nil {
+			// The owner exists now and setup cannot be repeated, so an error,
+			// which taints the resource, would leave it unrecoverable
+			resp.Diagnostics.AddWarning(
+				"Error Creating n8n API Key",
+				"The owner was set up, but the API key could not be created, so api_key is empty. Create the key manually in the n8n UI: "+err.Error(),
+			)
+		} else {
+			plan.APIKey = types.StringValue(key.APIKey)
+		}
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ownerSetupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Owner setup is a one-time action that the API key cannot read back, so
+	// the state is kept as is.
+	var state ownerSetupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update records the new configuration without changing the instance.
+func (r *ownerSetupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ownerSetupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"n8n Owner Not Updated",
+		"The instance owner is only configured during the initial setup. "+
+			"Use the n8n_user resource or the n8n UI to change the owner's details.",
+	)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the Terraform state only.
+func (r *ownerSetupResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// n8n cannot be returned to its unconfigured state through the API
+}
@@ -0,0 +1,53 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestAccOwnerSetupResource(t *testing.T) {
+	testAccPreCheck(t)
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	// The instance of the other tests already has an owner
+	container, endpoint, err := startN8N(context.Background())
+	testcontainers.CleanupContainer(t, container)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+# The API key of the other tests is not valid for this instance
+provider "n8n" {
+  endpoint = %q
+  api_key  = ""
+}
+
+resource "n8n_owner_setup" "test" {
+  email      = "owner@example.com"
+  first_name = "Acceptance"
+  last_name  = "Test"
+  password   = "Acceptance-Test-1"
+
+  api_key_label = "acceptance"
+}
+`, endpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_owner_setup.test", "id"),
+					resource.TestCheckResourceAttrSet("n8n_owner_setup.test", "api_key"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pendingInvitationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &pendingInvitationsDataSource{}
+)
+
+// NewPendingInvitationsDataSource is a helper function to simplify the provider implementation.
+func NewPendingInvitationsDataSource() datasource.DataSource {
+	return &pendingInvitationsDataSource{}
+}
+
+// pendingInvitationsDataSource is the data source implementation.
+type pendingInvitationsDataSource struct {
+	client client.UsersService
+}
+
+// pendingInvitationsDataSourceModel maps the data source schema data.
+type pendingInvitationsDataSourceModel struct {
+	Invitations []pendingInvitationModel `tfsdk:"invitations"`
+}
+
+// pendingInvitationModel maps a single pending invitation.
+type pendingInvitationModel struct {
+	UserID          types.String `tfsdk:"user_id"`
+	Email           types.String `tfsdk:"email"`
+	Role            types.String `tfsdk:"role"`
+	InviteAcceptURL types.String `tfsdk:"invite_accept_url"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *pendingInvitationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pending_invitations"
+}
+
+// Schema defines the schema for the data source.
+func (d *pendingInvitationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the user invitations that have not been accepted yet.",
+		Attributes: map[string]schema.Attribute{
+			"invitations": schema.ListNestedAttribute{
+				Description: "Pending invitations",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "ID of the invited user",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address the invitation was sent to",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role the user was invited with",
+							Computed:    true,
+						},
+						"invite_accept_url": schema.StringAttribute{
+							Description: "URL to accept the invitation; empty if n8n does not report it",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the user was invited",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pendingInvitationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.UsersService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pendingInvitationsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &pendingInvitationsDataSource{client: withContext(d.client, ctx)}
+
+	var state pendingInvitationsDataSourceModel
+
+	users, err := d.client.ListUsers()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Invitations",
+			"Could not list n8n users: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.Invitations = []pendingInvitationModel{}
+	for _, user := range users {
+		if !user.IsPending {
+			continue
+		}
+		state.Invitations = append(state.Invitations, pendingInvitationModel{
+			UserID:          types.StringValue(user.ID),
+			Email:           types.StringValue(user.Email),
+			Role:            types.StringValue(user.GetRole()),
+			InviteAcceptURL: types.StringValue(user.InviteAcceptURL),
+			CreatedAt:       types.StringValue(user.CreatedAt),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectDataSource{}
+)
+
+// NewProjectDataSource is a helper function to simplify the provider implementation.
+func NewProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+// projectDataSource is the data source implementation.
+type projectDataSource struct {
+	client client.ProjectsService
+}
+
+// Metadata returns the data source type name.
+func (d *projectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches an n8n project by name. Requires the n8n projects feature.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the project. Lookup fails if no project or more than one project has this name.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Project identifier",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Kind of project: 'team' or 'personal'",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ProjectsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &projectDataSource{client: withContext(d.client, ctx)}
+
+	var state projectModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.ListProjects()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Project",
+			"Could not list n8n projects: "+err.Error(),
+		)
+		return
+	}
+
+	name := state.Name.ValueString()
+	var found *client.Project
+	for i := range projects {
+		if projects[i].Name != name {
+			continue
+		}
+		if found != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading n8n Project",
+				fmt.Sprintf("Found multiple projects named %q (IDs %s and %s); use the n8n_projects data source to pick one.", name, found.ID, projects[i].ID),
+			)
+			return
+		}
+		found = &projects[i]
+	}
+	if found == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Project",
+			fmt.Sprintf("No project is named %q.", name),
+		)
+		return
+	}
+
+	// Map response to state
+	state.ID = types.StringValue(found.ID)
+	state.Type = types.StringValue(found.Type)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
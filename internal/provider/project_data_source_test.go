@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// fakeProjects serves a fixed list of projects. Other methods of the
+// service are not implemented.
+type fakeProjects struct {
+	client.ProjectsService
+	projects []client.Project
+}
+
+func (f *fakeProjects) ListProjects() ([]client.Project, error) {
+	return f.projects, nil
+}
+
+func TestProjectDataSourceRead(t *testing.T) {
+	projects := []client.Project{
+		{ID: "p1", Name: "Sales", Type: "team"},
+		{ID: "p2", Name: "Support", Type: "team"},
+		{ID: "p3", Name: "Support", Type: "team"},
+	}
+
+	tests := map[string]struct {
+		name    string
+		wantID  string
+		wantErr string
+	}{
+		"found": {
+			name:   "Sales",
+			wantID: "p1",
+		},
+		"missing": {
+			name:    "Marketing",
+			wantErr: `No project is named "Marketing".`,
+		},
+		"ambiguous": {
+			name:    "Support",
+			wantErr: "Found multiple projects named",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			d := &projectDataSource{}
+
+			var configureResp datasource.ConfigureResponse
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: &fakeProjects{projects: projects}}, &configureResp)
+			if configureResp.Diagnostics.HasError() {
+				t.Fatalf("Configure() = %v", configureResp.Diagnostics)
+			}
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tt.name),
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"type": tftypes.NewValue(tftypes.String, nil),
+			})
+
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, &resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("Read() = %v, want an error mentioning %q", resp.Diagnostics, tt.wantErr)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() = %v", resp.Diagnostics)
+			}
+
+			var state projectModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.ID.ValueString() != tt.wantID {
+				t.Errorf("id = %q, want %q", state.ID.ValueString(), tt.wantID)
+			}
+		})
+	}
+}
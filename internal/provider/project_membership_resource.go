@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectMembershipResource{}
+	_ resource.ResourceWithConfigure   = &projectMembershipResource{}
+	_ resource.ResourceWithImportState = &projectMembershipResource{}
+)
+
+// NewProjectMembershipResource is a helper function to simplify the provider implementation.
+func NewProjectMembershipResource() resource.Resource {
+	return &projectMembershipResource{}
+}
+
+// projectMembershipResource is the resource implementation.
+type projectMembershipResource struct {
+	client client.ProjectsService
+}
+
+// projectMembershipResourceModel maps the resource schema data.
+type projectMembershipResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	UserID    types.String `tfsdk:"user_id"`
+	Role      types.String `tfsdk:"role"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the membership of a user in an n8n project. Requires the n8n projects feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier in the form project_id:user_id",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user to add to the project",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the user in the project: 'project:admin', 'project:editor', 'project:viewer', or the ID of an n8n_custom_role",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^project:.+`), "must be a project role such as 'project:editor'"),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ProjectsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &projectMembershipResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan projectMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddProjectUser(plan.ProjectID.ValueString(), plan.UserID.ValueString(), plan.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding User to n8n Project",
+			"Could not add user "+plan.UserID.ValueString()+" to project "+plan.ProjectID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ProjectID.ValueString() + ":" + plan.UserID.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state projectMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cancel the requests of this operation together with it
+	r = &projectMembershipResource{client: withContext(r.client, ctx)}
+
+	members, err := r.client.ListProjectMembers(state.ProjectID.ValueString())
+	if err != nil {
+		// The project was deleted outside of Terraform
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Project Membership",
+			"Could not list the members of project "+state.ProjectID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var member *client.ProjectMember
+	for i := range members {
+		if members[i].UserID == state.UserID.ValueString() {
+			member = &members[i]
+			break
+		}
+	}
+	if member == nil {
+		// The user was removed from the project outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	switch {
+	case member.Role != "":
+		state.Role = types.StringValue(member.Role)
+	case state.Role.IsNull():
+		// Only the ID is known after an import
+		resp.Diagnostics.AddWarning(
+			"n8n Project Role Not Reported",
+			"The n8n instance does not report the role of user "+state.UserID.ValueString()+" in project "+state.ProjectID.ValueString()+
+				", so the next apply sets the configured role.",
+		)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &projectMembershipResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan projectMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can change in place
+	err := r.client.UpdateProjectUserRole(plan.ProjectID.ValueString(), plan.UserID.ValueString(), plan.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating n8n Project Membership",
+			"Could not change the role of user "+plan.UserID.ValueString()+" in project "+plan.ProjectID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &projectMembershipResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state projectMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveProjectUser(state.ProjectID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		// The user or project may already be gone
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing User from n8n Project",
+			"Could not remove user "+state.UserID.ValueString()+" from project "+state.ProjectID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *projectMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using project_id:user_id
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import ID in the form project_id:user_id, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
@@ -0,0 +1,53 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccProjectMembershipResource(t *testing.T) {
+	projectID := testAccProject(t)
+	config := func(role string) string {
+		return fmt.Sprintf(`
+resource "n8n_user" "test" {
+  email = "tf-acc-member@example.com"
+}
+
+resource "n8n_project_membership" "test" {
+  project_id = %q
+  user_id    = n8n_user.test.id
+  role       = %q
+}
+`, projectID, role)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("project:editor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_project_membership.test", "project_id", projectID),
+					resource.TestCheckResourceAttrPair("n8n_project_membership.test", "user_id", "n8n_user.test", "id"),
+					resource.TestCheckResourceAttr("n8n_project_membership.test", "role", "project:editor"),
+				),
+			},
+			{
+				Config:           config("project:viewer"),
+				ConfigPlanChecks: testAccExpectAction("n8n_project_membership.test", plancheck.ResourceActionUpdate),
+				Check:            resource.TestCheckResourceAttr("n8n_project_membership.test", "role", "project:viewer"),
+			},
+			{
+				ResourceName:      "n8n_project_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
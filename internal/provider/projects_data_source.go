@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectsDataSource{}
+)
+
+// NewProjectsDataSource is a helper function to simplify the provider implementation.
+func NewProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+// projectsDataSource is the data source implementation.
+type projectsDataSource struct {
+	client client.ProjectsService
+}
+
+// projectsDataSourceModel maps the data source schema data.
+type projectsDataSourceModel struct {
+	Projects []projectModel `tfsdk:"projects"`
+}
+
+// projectModel maps a single project.
+type projectModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// Metadata returns the data source type name.
+func (d *projectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the projects of an n8n instance. Requires the n8n projects feature.",
+		Attributes: map[string]schema.Attribute{
+			"projects": schema.ListNestedAttribute{
+				Description: "Projects of the instance",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Project identifier",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the project",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Kind of project: 'team' or 'personal'",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ProjectsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &projectsDataSource{client: withContext(d.client, ctx)}
+
+	var state projectsDataSourceModel
+
+	projects, err := d.client.ListProjects()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Projects",
+			"Could not list n8n projects: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.Projects = make([]projectModel, 0, len(projects))
+	for _, project := range projects {
+		state.Projects = append(state.Projects, projectModel{
+			ID:   types.StringValue(project.ID),
+			Name: types.StringValue(project.Name),
+			Type: types.StringValue(project.Type),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -3,12 +3,23 @@ package provider
 import (
 	"context"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/pinotelio/terraform-provider-n8n/internal/client"
@@ -16,9 +27,14 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &n8nProvider{}
+	_ provider.Provider                       = &n8nProvider{}
+	_ provider.ProviderWithFunctions          = &n8nProvider{}
+	_ provider.ProviderWithEphemeralResources = &n8nProvider{}
 )
 
+// durationPattern matches the durations accepted by time.ParseDuration
+var durationPattern = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -38,8 +54,38 @@ type n8nProvider struct {
 
 // n8nProviderModel maps provider schema data to a Go type.
 type n8nProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Endpoint                  types.String               `tfsdk:"endpoint"`
+	APIKey                    types.String               `tfsdk:"api_key"`
+	APIKeyFile                types.String               `tfsdk:"api_key_file"`
+	APIKeyCommand             types.List                 `tfsdk:"api_key_command"`
+	WorkflowListRefresh       types.Bool                 `tfsdk:"workflow_list_refresh"`
+	ExcludePinnedData         types.Bool                 `tfsdk:"exclude_pinned_data"`
+	WebhookBaseURL            types.String               `tfsdk:"webhook_base_url"`
+	PageSize                  types.Int64                `tfsdk:"page_size"`
+	MaxRetries                types.Int64                `tfsdk:"max_retries"`
+	RetryMaxDelay             types.String               `tfsdk:"retry_max_delay"`
+	RequestsPerSecond         types.Float64              `tfsdk:"requests_per_second"`
+	CACertPEM                 types.String               `tfsdk:"ca_cert_pem"`
+	CACertFile                types.String               `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify        types.Bool                 `tfsdk:"insecure_skip_tls_verify"`
+	ClientCertPEM             types.String               `tfsdk:"client_cert_pem"`
+	ClientKeyPEM              types.String               `tfsdk:"client_key_pem"`
+	ProxyURL                  types.String               `tfsdk:"proxy_url"`
+	ExtraHeaders              types.Map                  `tfsdk:"extra_headers"`
+	BasicAuth                 *n8nProviderBasicAuthModel `tfsdk:"basic_auth"`
+	BearerToken               types.String               `tfsdk:"bearer_token"`
+	SkipCredentialsValidation types.Bool                 `tfsdk:"skip_credentials_validation"`
+	HTTPTimeout               types.String               `tfsdk:"http_timeout"`
+	MaxIdleConns              types.Int64                `tfsdk:"max_idle_conns"`
+	IdleConnTimeout           types.String               `tfsdk:"idle_conn_timeout"`
+	MaxConcurrentRequests     types.Int64                `tfsdk:"max_concurrent_requests"`
+	ReadCacheTTL              types.String               `tfsdk:"read_cache_ttl"`
+}
+
+// n8nProviderBasicAuthModel maps the basic_auth attribute.
+type n8nProviderBasicAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
 }
 
 // Metadata returns the provider type name.
@@ -58,10 +104,193 @@ func (p *n8nProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "The n8n API key for authentication. May also be provided via N8N_API_KEY environment variable.",
+				Description: "The n8n API key for authentication. May also be provided via N8N_API_KEY environment variable. " +
+					"Only optional while bootstrapping a new instance with n8n_owner_setup.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key_file"), path.MatchRoot("api_key_command")),
+				},
+			},
+			"api_key_file": schema.StringAttribute{
+				Description: "Path to a file the n8n API key is read from, e.g. one written by a secrets agent, so that the key does not pass through Terraform variables. " +
+					"Surrounding whitespace is ignored. Conflicts with api_key and api_key_command.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key_command")),
+				},
+			},
+			"api_key_command": schema.ListAttribute{
+				Description: "Command that prints the n8n API key, given as the program and its arguments, e.g. [\"vault\", \"kv\", \"get\", \"-field=api_key\", \"secret/n8n\"]. " +
+					"It is run without a shell when the provider is configured, and must finish within a minute. Conflicts with api_key and api_key_file.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"workflow_list_refresh": schema.BoolAttribute{
+				Description: "Refresh n8n_workflow resources from a single list of all workflows instead of one request per workflow. " +
+					"This makes refreshing large states much faster, at the cost of reading a snapshot taken when the first workflow is refreshed. Defaults to false.",
+				Optional: true,
+			},
+			"exclude_pinned_data": schema.BoolAttribute{
+				Description: "Read workflows without the output pinned to their nodes, except for n8n_workflow resources that set pin_data. " +
+					"This keeps large pinned test data out of memory and state during refreshes. Defaults to true.",
+				Optional: true,
+			},
+			"read_cache_ttl": schema.StringAttribute{
+				Description: "How long a workflow read during a refresh is reused by the other resources of the same workflow, such as n8n_workflow_activation and n8n_workflow_settings, as a duration such as '30s'. " +
+					"Changes made by the provider are never served from the cache. '0s' disables it. Defaults to '30s'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationPattern, "must be a duration such as '10s' or '1m'"),
+				},
+			},
+			"webhook_base_url": schema.StringAttribute{
+				Description: "Base URL n8n serves webhooks under, used to build the webhook_urls of workflows. " +
+					"May also be provided via N8N_WEBHOOK_URL environment variable. Defaults to the endpoint.",
+				Optional: true,
+			},
+			"page_size": schema.Int64Attribute{
+				Description: "Number of items requested per page when listing workflows, credentials, users and other objects. " +
+					"All pages are always read; smaller pages help proxies with response size limits. Defaults to 250, the maximum of the n8n API.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 250),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How often a request that failed with a transient error, such as a 502, 503 or 504 from a proxy in front of n8n, is sent again. " +
+					"Rate limited requests (429) are sent again after the wait given by their Retry-After header. " +
+					"Requests that may already have changed something in n8n are only repeated when that is safe. Set to 0 to disable retries. Defaults to 3.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_delay": schema.StringAttribute{
+				Description: "Longest wait between two attempts of a request, as a duration such as '30s'. The wait doubles with each attempt, starting at about half a second. Defaults to '30s'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationPattern, "must be a duration such as '10s' or '1m'"),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests to the n8n API in flight at once, shared by all resources. " +
+					"Terraform applies up to 10 resources in parallel, which can overwhelm small instances, especially those with a SQLite database. Unlimited by default.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to the n8n API, shared by all resources, to stay below the rate limit of n8n cloud or a proxy. " +
+					"Fractions such as 0.5 are allowed. Unlimited by default.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust for the connection to n8n, in addition to the CAs of the system, " +
+					"e.g. for instances with a certificate of an internal CA.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file with PEM encoded CA certificates to trust for the connection to n8n. Conflicts with ca_cert_pem.",
+				Optional:    true,
+			},
+			"insecure_skip_tls_verify": schema.BoolAttribute{
+				Description: "Do not verify the TLS certificate of n8n. This makes the connection vulnerable to interception and should only be used for testing; " +
+					"prefer ca_cert_pem or ca_cert_file for instances with self-signed certificates. Defaults to false.",
+				Optional: true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to n8n, for instances behind a gateway that requires mutual TLS. Requires client_key_pem.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of client_cert_pem.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy requests to n8n are sent through, such as 'http://proxy.example.com:3128' or 'socks5://bastion.example.com:1080'. " +
+					"Credentials may be given in the URL. May also be provided via N8N_PROXY_URL environment variable. " +
+					"Defaults to the proxy in the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent with every request, e.g. CF-Access-Client-Id and CF-Access-Client-Secret for instances behind Cloudflare Access. " +
+					"They cannot replace the headers the provider sets itself, such as X-N8N-API-KEY.",
+				ElementType: types.StringType,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"basic_auth": schema.SingleNestedAttribute{
+				Description: "Credentials sent as HTTP basic authentication with every request, for instances behind a reverse proxy that requires them. " +
+					"The API key is still sent in its own header when it is set; it may be omitted when the proxy authenticates the requests on its own.",
+				Optional: true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("bearer_token")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "Basic authentication username",
+						Required:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Basic authentication password",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"bearer_token": schema.StringAttribute{
+				Description: "Token sent in an Authorization: Bearer header with every request, for gateways and setups that authenticate with bearer tokens. " +
+					"When set, the API key may be omitted. May also be provided via N8N_BEARER_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip the request that checks the endpoint and the API key when the provider is configured. " +
+					"Without the check, a misconfigured provider only fails once resources are read. Defaults to false.",
+				Optional: true,
+			},
+			"http_timeout": schema.StringAttribute{
+				Description: "Timeout of a single request to the n8n API, as a duration such as '2m'. '0s' disables it. " +
+					"Resources with a timeouts block are bounded by the timeout of their operation instead. Defaults to '30s'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationPattern, "must be a duration such as '10s' or '1m'"),
+				},
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				Description: "Number of idle connections to n8n kept open for reuse. Raise it when many resources are refreshed in parallel. Defaults to 2.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"idle_conn_timeout": schema.StringAttribute{
+				Description: "How long an idle connection to n8n is kept open, as a duration such as '90s'. " +
+					"Lower it when a proxy or load balancer closes idle connections sooner. Defaults to '90s'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationPattern, "must be a duration such as '10s' or '1m'"),
+				},
+			},
 		},
 	}
 }
@@ -97,6 +326,14 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
+	if config.APIKeyFile.IsUnknown() || config.APIKeyCommand.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown n8n API Key Source",
+			"The provider cannot create the n8n API client as there is an unknown configuration value for api_key_file or api_key_command. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -106,6 +343,7 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 
 	endpoint := os.Getenv("N8N_ENDPOINT")
 	apiKey := os.Getenv("N8N_API_KEY")
+	bearerToken := os.Getenv("N8N_BEARER_TOKEN")
 
 	if !config.Endpoint.IsNull() {
 		endpoint = config.Endpoint.ValueString()
@@ -115,6 +353,42 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		apiKey = config.APIKey.ValueString()
 	}
 
+	if !config.APIKeyFile.IsNull() {
+		var err error
+		apiKey, err = readAPIKeyFile(config.APIKeyFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Invalid n8n API Key File",
+				"Could not read the n8n API key: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !config.APIKeyCommand.IsNull() {
+		var args []string
+		resp.Diagnostics.Append(config.APIKeyCommand.ElementsAs(ctx, &args, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		apiKey, err = runAPIKeyCommand(ctx, args)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_command"),
+				"Failed to Run n8n API Key Command",
+				"Could not get the n8n API key from "+args[0]+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !config.BearerToken.IsNull() {
+		bearerToken = config.BearerToken.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -128,11 +402,15 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
-	if apiKey == "" {
-		resp.Diagnostics.AddAttributeError(
+	// A missing API key is only a warning so that a new instance can be
+	// bootstrapped with n8n_owner_setup; other requests fail without it,
+	// unless a bearer token or basic authentication authenticates them.
+	basicAuth := config.BasicAuth != nil
+	if apiKey == "" && bearerToken == "" && !basicAuth {
+		resp.Diagnostics.AddAttributeWarning(
 			path.Root("api_key"),
 			"Missing n8n API Key",
-			"The provider cannot create the n8n API client as there is a missing or empty value for the n8n API key. "+
+			"The provider has no n8n API key, so only n8n_owner_setup can be used. "+
 				"Set the api_key value in the configuration or use the N8N_API_KEY environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
@@ -144,20 +422,211 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 
 	// Create a new n8n client using the configuration values
 	n8nClient := client.NewClient(endpoint, apiKey)
+	n8nClient.UserAgent = userAgent(p.version, req.TerraformVersion)
+	n8nClient.WorkflowListRefresh = config.WorkflowListRefresh.ValueBool()
+	if !config.ExcludePinnedData.IsNull() {
+		n8nClient.ExcludePinnedData = config.ExcludePinnedData.ValueBool()
+	}
+	n8nClient.BearerToken = bearerToken
+	if basicAuth {
+		if bearerToken != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("basic_auth"),
+				"Conflicting n8n Authentication",
+				"Both basic_auth and a bearer token are configured, but requests can only carry one of them. "+
+					"Remove basic_auth, or unset bearer_token and the N8N_BEARER_TOKEN environment variable.",
+			)
+			return
+		}
+		n8nClient.BasicAuthUsername = config.BasicAuth.Username.ValueString()
+		n8nClient.BasicAuthPassword = config.BasicAuth.Password.ValueString()
+	}
+	if !config.PageSize.IsNull() {
+		n8nClient.PageSize = int(config.PageSize.ValueInt64())
+	}
+	if !config.MaxRetries.IsNull() {
+		n8nClient.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RequestsPerSecond.IsNull() {
+		n8nClient.SetRequestsPerSecond(config.RequestsPerSecond.ValueFloat64())
+	}
+	if !config.MaxConcurrentRequests.IsNull() {
+		n8nClient.SetMaxConcurrentRequests(int(config.MaxConcurrentRequests.ValueInt64()))
+	}
+	if !config.ReadCacheTTL.IsNull() {
+		if readCacheTTL, err := time.ParseDuration(config.ReadCacheTTL.ValueString()); err == nil {
+			n8nClient.ReadCacheTTL = readCacheTTL
+		}
+	}
+	if !config.HTTPTimeout.IsNull() {
+		if httpTimeout, err := time.ParseDuration(config.HTTPTimeout.ValueString()); err == nil {
+			n8nClient.HTTPClient.Timeout = httpTimeout
+		}
+	}
+	if !config.MaxIdleConns.IsNull() || !config.IdleConnTimeout.IsNull() {
+		var idleConnTimeout time.Duration
+		if parsed, err := time.ParseDuration(config.IdleConnTimeout.ValueString()); err == nil {
+			idleConnTimeout = parsed
+		}
+		n8nClient.SetIdleConnections(int(config.MaxIdleConns.ValueInt64()), idleConnTimeout)
+	}
+	if !config.RetryMaxDelay.IsNull() {
+		if retryMaxDelay, err := time.ParseDuration(config.RetryMaxDelay.ValueString()); err == nil {
+			n8nClient.RetryMaxDelay = retryMaxDelay
+		}
+	}
+
+	caCertPEM := config.CACertPEM.ValueString()
+	caCertPath := path.Root("ca_cert_pem")
+	if !config.CACertFile.IsNull() {
+		caCertPath = path.Root("ca_cert_file")
+		pem, err := os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				caCertPath,
+				"Invalid CA Certificate File",
+				"Could not read the CA certificates for the connection to n8n: "+err.Error(),
+			)
+			return
+		}
+		caCertPEM = string(pem)
+	}
+	if caCertPEM != "" {
+		if err := n8nClient.SetCACertificates([]byte(caCertPEM)); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				caCertPath,
+				"Invalid CA Certificates",
+				"Could not load the CA certificates for the connection to n8n: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !config.ClientCertPEM.IsNull() && !config.ClientKeyPEM.IsNull() {
+		if err := n8nClient.SetClientCertificate([]byte(config.ClientCertPEM.ValueString()), []byte(config.ClientKeyPEM.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_cert_pem"),
+				"Invalid Client Certificate",
+				"Could not load the client certificate and key for the connection to n8n: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !config.ExtraHeaders.IsNull() {
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &n8nClient.ExtraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	proxyURL := os.Getenv("N8N_PROXY_URL")
+	if !config.ProxyURL.IsNull() {
+		proxyURL = config.ProxyURL.ValueString()
+	}
+	if proxyURL != "" {
+		if err := n8nClient.SetProxy(proxyURL); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy URL",
+				"Could not configure the proxy for the connection to n8n: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if config.InsecureSkipVerify.ValueBool() {
+		n8nClient.SetInsecureSkipVerify(true)
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_tls_verify"),
+			"TLS Certificate Verification Disabled",
+			"The provider does not verify the TLS certificate of n8n, so the API key and all data sent to n8n can be intercepted. "+
+				"Use ca_cert_pem or ca_cert_file to trust the certificate of the instance instead.",
+		)
+	}
+
+	webhookBaseURL := os.Getenv("N8N_WEBHOOK_URL")
+	if !config.WebhookBaseURL.IsNull() {
+		webhookBaseURL = config.WebhookBaseURL.ValueString()
+	}
+	if webhookBaseURL != "" {
+		n8nClient.WebhookBaseURL = strings.TrimSuffix(webhookBaseURL, "/")
+	}
+
+	// Fail once here instead of in every resource when the endpoint or the
+	// credentials are wrong. Without credentials, only n8n_owner_setup can be
+	// used, which does not need them.
+	if !config.SkipCredentialsValidation.ValueBool() && (apiKey != "" || bearerToken != "" || basicAuth) {
+		if err := n8nClient.WithContext(ctx).CheckConnection(); err != nil {
+			switch {
+			case client.IsUnauthorized(err):
+				credentialsPath, credentials := path.Root("api_key"), "API key"
+				switch {
+				case apiKey != "":
+				case bearerToken != "":
+					credentialsPath, credentials = path.Root("bearer_token"), "bearer token"
+				default:
+					credentialsPath, credentials = path.Root("basic_auth"), "basic authentication"
+				}
+				resp.Diagnostics.AddAttributeError(
+					credentialsPath,
+					"Invalid n8n Credentials",
+					"The n8n API at "+endpoint+" rejected the "+credentials+". Check that it is correct, has not expired and belongs to this instance.\n\n"+err.Error(),
+				)
+			case client.IsNotFound(err):
+				resp.Diagnostics.AddAttributeError(
+					path.Root("endpoint"),
+					"n8n API Not Found",
+					"No n8n public API was found at "+endpoint+". Check that the endpoint is the base URL of the instance, without /api/v1, "+
+						"and that the public API is not disabled with N8N_PUBLIC_API_DISABLED.\n\n"+err.Error(),
+				)
+			default:
+				resp.Diagnostics.AddAttributeError(
+					path.Root("endpoint"),
+					"Unable to Reach n8n",
+					"Could not connect to the n8n API at "+endpoint+": "+err.Error()+"\n\n"+
+						"Set skip_credentials_validation to configure the provider without this check.",
+				)
+			}
+			return
+		}
+	}
 
 	// Make the n8n client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = n8nClient
 	resp.ResourceData = n8nClient
+	resp.EphemeralResourceData = n8nClient
+}
+
+// userAgent identifies the provider and Terraform versions in requests to
+// n8n, e.g. terraform-provider-n8n/1.2.0 terraform/1.9.5.
+func userAgent(providerVersion, terraformVersion string) string {
+	userAgent := "terraform-provider-n8n/" + providerVersion
+	if terraformVersion != "" {
+		userAgent += " terraform/" + terraformVersion
+	}
+	return userAgent
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *n8nProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewWorkflowDataSource,
+		NewWorkflowsDataSource,
 		// NewCredentialDataSource is not included because the n8n API does not
 		// support reading credentials for security reasons. See CREDENTIAL_LIMITATIONS.md
 		NewUserDataSource,
+		NewUsersDataSource,
+		NewPendingInvitationsDataSource,
+		NewInsightsSummaryDataSource,
+		NewWorkflowValidationDataSource,
+		NewExecutionsDataSource,
+		NewInstanceInfoDataSource,
+		NewAuditDataSource,
+		NewRolesDataSource,
+		NewProjectsDataSource,
+		NewProjectDataSource,
 	}
 }
 
@@ -166,7 +635,39 @@ func (p *n8nProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewWorkflowResource,
 		NewWorkflowActivationResource,
+		NewFolderResource,
+		NewWorkflowTagsResource,
+		NewWorkflowSettingsResource,
+		NewWorkflowExecutionResource,
+		NewExecutionPruneResource,
 		NewCredentialResource,
+		NewCredentialSharingResource,
 		NewUserResource,
+		NewUserInvitationsResource,
+		NewProjectMembershipResource,
+		NewSourceControlResource,
+		NewSourceControlPullResource,
+		NewInstanceSettingsResource,
+		NewLicenseResource,
+		NewSAMLConfigResource,
+		NewLogStreamingDestinationResource,
+		NewCommunityPackageResource,
+		NewAPIKeyResource,
+		NewOwnerSetupResource,
+		NewCustomRoleResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *n8nProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewCredentialEphemeralResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *n8nProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBuildConnectionsFunction,
 	}
 }
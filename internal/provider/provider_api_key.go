@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// apiKeyCommandTimeout bounds how long api_key_command may run
+const apiKeyCommandTimeout = time.Minute
+
+// readAPIKeyFile reads the API key from a file, ignoring surrounding
+// whitespace such as a trailing newline
+func readAPIKeyFile(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		return "", fmt.Errorf("%s is empty", name)
+	}
+	return apiKey, nil
+}
+
+// runAPIKeyCommand runs a command, given as the program and its arguments,
+// and returns what it prints as the API key
+func runAPIKeyCommand(ctx context.Context, args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, apiKeyCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("%w: %s", err, message)
+		}
+		return "", err
+	}
+
+	apiKey := strings.TrimSpace(stdout.String())
+	if apiKey == "" {
+		return "", fmt.Errorf("%s printed no API key", args[0])
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+	"github.com/pinotelio/terraform-provider-n8n/internal/vcr"
+)
+
+// cassettes is the directory the recorded responses of n8n are kept in
+const cassettes = "testdata/cassettes"
+
+// resourceState returns a state of a resource with only the given
+// attributes set, as after an import.
+func resourceState(t *testing.T, r resource.Resource, values map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("schema type is not an object")
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+	return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestReplayWorkflowResourceImport(t *testing.T) {
+	vcr.Run(t, cassettes, "workflow_import.json", func(t *testing.T, s *vcr.Server) {
+		ctx := context.Background()
+		r := &workflowResource{client: client.NewClient(s.URL, "replay")}
+
+		state := resourceState(t, r, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "Ue2zH8Lr6qIiYF1b"),
+		})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read() = %v", resp.Diagnostics)
+		}
+
+		var got workflowResourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("state: %v", resp.Diagnostics)
+		}
+		// The configuration generated from an import only sets workflow_json
+		var workflowJSON map[string]interface{}
+		if err := json.Unmarshal([]byte(got.WorkflowJSON.ValueString()), &workflowJSON); err != nil {
+			t.Fatalf("workflow_json: %v", err)
+		}
+		var tagNames []string
+		resp.Diagnostics.Append(got.TagNames.ElementsAs(ctx, &tagNames, false)...)
+
+		switch {
+		case workflowJSON["name"] != "Orders" || !got.Name.IsNull():
+			t.Errorf("workflow_json name = %v, name = %v", workflowJSON["name"], got.Name)
+		case !got.Active.ValueBool():
+			t.Error("active = false, want true")
+		case got.ProjectID.ValueString() != "Xk4nq2PeUoR8vT1d":
+			t.Errorf("project_id = %q", got.ProjectID.ValueString())
+		case got.NodeCount.ValueInt64() != 2 || !got.HasWebhook.ValueBool():
+			t.Errorf("node_count = %d, has_webhook = %v", got.NodeCount.ValueInt64(), got.HasWebhook.ValueBool())
+		case len(tagNames) != 1 || tagNames[0] != "production":
+			t.Errorf("tag_names = %q", tagNames)
+		}
+	})
+}
+
+func TestReplayWorkflowResourceDelete(t *testing.T) {
+	// n8n versions that archive workflows on delete need a second delete
+	vcr.Run(t, cassettes, "workflow_delete.json", func(t *testing.T, s *vcr.Server) {
+		ctx := context.Background()
+		r := &workflowResource{client: client.NewClient(s.URL, "replay")}
+
+		state := resourceState(t, r, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "Ue2zH8Lr6qIiYF1b"),
+		})
+		resp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Delete() = %v", resp.Diagnostics)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &rolesDataSource{}
+)
+
+// NewRolesDataSource is a helper function to simplify the provider implementation.
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// rolesDataSource is the data source implementation.
+type rolesDataSource struct {
+	client client.RolesService
+}
+
+// rolesDataSourceModel maps the data source schema data.
+type rolesDataSourceModel struct {
+	RoleType types.String `tfsdk:"role_type"`
+	Roles    []roleModel  `tfsdk:"roles"`
+}
+
+// roleModel maps a single role.
+type roleModel struct {
+	Slug        types.String `tfsdk:"slug"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	RoleType    types.String `tfsdk:"role_type"`
+	Scopes      types.Set    `tfsdk:"scopes"`
+	SystemRole  types.Bool   `tfsdk:"system_role"`
+}
+
+// Metadata returns the data source type name.
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the built-in and custom roles of an n8n instance.",
+		Attributes: map[string]schema.Attribute{
+			"role_type": schema.StringAttribute{
+				Description: "Only return roles of this type: 'global', 'project', 'workflow' or 'credential'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("global", "project", "workflow", "credential"),
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "Roles of the instance",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slug": schema.StringAttribute{
+							Description: "Role identifier, e.g. 'global:member' or 'project:editor'",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "Name of the role shown in n8n",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the role",
+							Computed:    true,
+						},
+						"role_type": schema.StringAttribute{
+							Description: "Kind of role",
+							Computed:    true,
+						},
+						"scopes": schema.SetAttribute{
+							Description: "Scopes granted by the role",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"system_role": schema.BoolAttribute{
+							Description: "Whether the role is built into n8n",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.RolesService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &rolesDataSource{client: withContext(d.client, ctx)}
+
+	var state rolesDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.ListRoles(state.RoleType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Roles",
+			"Could not list n8n roles: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.Roles = make([]roleModel, 0, len(roles))
+	for _, role := range roles {
+		scopes, diags := types.SetValueFrom(ctx, types.StringType, role.Scopes)
+		resp.Diagnostics.Append(diags...)
+
+		state.Roles = append(state.Roles, roleModel{
+			Slug:        types.StringValue(role.Slug),
+			DisplayName: types.StringValue(role.DisplayName),
+			Description: types.StringValue(role.Description),
+			RoleType:    types.StringValue(role.RoleType),
+			Scopes:      scopes,
+			SystemRole:  types.BoolValue(role.SystemRole),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
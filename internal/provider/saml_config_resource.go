@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// samlConfigID is the fixed identifier of the instance-wide SAML configuration.
+const samlConfigID = "saml"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &samlConfigResource{}
+	_ resource.ResourceWithConfigure   = &samlConfigResource{}
+	_ resource.ResourceWithImportState = &samlConfigResource{}
+)
+
+// NewSAMLConfigResource is a helper function to simplify the provider implementation.
+func NewSAMLConfigResource() resource.Resource {
+	return &samlConfigResource{}
+}
+
+// samlConfigResource is the resource implementation.
+type samlConfigResource struct {
+	client client.SAMLService
+}
+
+// samlConfigResourceModel maps the resource schema data.
+type samlConfigResourceModel struct {
+	ID               types.String               `tfsdk:"id"`
+	MetadataXML      types.String               `tfsdk:"metadata_xml"`
+	MetadataURL      types.String               `tfsdk:"metadata_url"`
+	LoginEnabled     types.Bool                 `tfsdk:"login_enabled"`
+	LoginLabel       types.String               `tfsdk:"login_label"`
+	AttributeMapping *samlAttributeMappingModel `tfsdk:"attribute_mapping"`
+	EntityID         types.String               `tfsdk:"entity_id"`
+	ReturnURL        types.String               `tfsdk:"return_url"`
+}
+
+// samlAttributeMappingModel maps the attribute_mapping attribute.
+type samlAttributeMappingModel struct {
+	Email             types.String `tfsdk:"email"`
+	FirstName         types.String `tfsdk:"first_name"`
+	LastName          types.String `tfsdk:"last_name"`
+	UserPrincipalName types.String `tfsdk:"user_principal_name"`
+}
+
+// Metadata returns the resource type name.
+func (r *samlConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saml_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *samlConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the SAML single sign-on configuration of an n8n instance. " +
+			"There is only one configuration per instance. Requires the n8n enterprise SAML feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier (always 'saml')",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metadata_xml": schema.StringAttribute{
+				Description: "Identity provider metadata XML. Exactly one of metadata_xml or metadata_url must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("metadata_url")),
+				},
+			},
+			"metadata_url": schema.StringAttribute{
+				Description: "URL from which n8n fetches the identity provider metadata. Exactly one of metadata_xml or metadata_url must be set.",
+				Optional:    true,
+			},
+			"login_enabled": schema.BoolAttribute{
+				Description: "Whether users can log in with SAML. Defaults to false.",
+				Optional:    true,
+			},
+			"login_label": schema.StringAttribute{
+				Description: "Label of the SAML login button",
+				Optional:    true,
+			},
+			"attribute_mapping": schema.SingleNestedAttribute{
+				Description: "Names of the SAML assertion attributes that hold the user's details",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"email": schema.StringAttribute{
+						Description: "Attribute holding the email address",
+						Optional:    true,
+					},
+					"first_name": schema.StringAttribute{
+						Description: "Attribute holding the first name",
+						Optional:    true,
+					},
+					"last_name": schema.StringAttribute{
+						Description: "Attribute holding the last name",
+						Optional:    true,
+					},
+					"user_principal_name": schema.StringAttribute{
+						Description: "Attribute holding the user principal name",
+						Optional:    true,
+					},
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				Description: "Service provider entity ID to register with the identity provider",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"return_url": schema.StringAttribute{
+				Description: "Assertion consumer service URL to register with the identity provider",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *samlConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SAMLService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *samlConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &samlConfigResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan samlConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.SetSAMLConfig(samlConfigFromModel(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Configuring n8n SAML",
+			"Could not configure SAML: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(samlConfigID)
+	plan.EntityID = types.StringValue(config.EntityID)
+	plan.ReturnURL = types.StringValue(config.ReturnURL)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *samlConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &samlConfigResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state samlConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetSAMLConfig()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n SAML",
+			"Could not read SAML configuration: "+err.Error(),
+		)
+		return
+	}
+
+	// Only the ID is known after an import
+	imported := state.EntityID.IsNull()
+	state.ID = types.StringValue(samlConfigID)
+	if config.LoginEnabled || !state.LoginEnabled.IsNull() {
+		state.LoginEnabled = types.BoolValue(config.LoginEnabled)
+	}
+	// n8n fills in the metadata fetched from metadata_url, so only track the
+	// source that is configured (preferring the URL after an import).
+	if !state.MetadataURL.IsNull() || (state.MetadataXML.IsNull() && config.MetadataURL != "") {
+		state.MetadataURL = types.StringValue(config.MetadataURL)
+	} else if config.Metadata != "" {
+		state.MetadataXML = types.StringValue(config.Metadata)
+	}
+	if !state.LoginLabel.IsNull() || (imported && config.LoginLabel != "") {
+		state.LoginLabel = types.StringValue(config.LoginLabel)
+	}
+	if imported && config.Mapping != nil {
+		state.AttributeMapping = &samlAttributeMappingModel{
+			Email:             optionalStringValue(types.StringNull(), config.Mapping.Email),
+			FirstName:         optionalStringValue(types.StringNull(), config.Mapping.FirstName),
+			LastName:          optionalStringValue(types.StringNull(), config.Mapping.LastName),
+			UserPrincipalName: optionalStringValue(types.StringNull(), config.Mapping.UserPrincipalName),
+		}
+	} else if state.AttributeMapping != nil && config.Mapping != nil {
+		state.AttributeMapping = &samlAttributeMappingModel{
+			Email:             optionalStringValue(state.AttributeMapping.Email, config.Mapping.Email),
+			FirstName:         optionalStringValue(state.AttributeMapping.FirstName, config.Mapping.FirstName),
+			LastName:          optionalStringValue(state.AttributeMapping.LastName, config.Mapping.LastName),
+			UserPrincipalName: optionalStringValue(state.AttributeMapping.UserPrincipalName, config.Mapping.UserPrincipalName),
+		}
+	}
+	state.EntityID = types.StringValue(config.EntityID)
+	state.ReturnURL = types.StringValue(config.ReturnURL)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *samlConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &samlConfigResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan samlConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.SetSAMLConfig(samlConfigFromModel(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating n8n SAML",
+			"Could not update SAML configuration: "+err.Error()+licenseHint(err, "SAML"),
+		)
+		return
+	}
+
+	plan.EntityID = types.StringValue(config.EntityID)
+	plan.ReturnURL = types.StringValue(config.ReturnURL)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete disables SAML login; n8n has no way to remove the configuration itself.
+func (r *samlConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &samlConfigResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state samlConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ToggleSAMLLogin(false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Disabling n8n SAML",
+			"Could not disable SAML login: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *samlConfigResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is only one configuration per instance, so any import ID will do
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), samlConfigID)...)
+}
+
+// samlConfigFromModel builds the API payload from the resource model.
+func samlConfigFromModel(model *samlConfigResourceModel) *client.SAMLConfig {
+	config := &client.SAMLConfig{
+		Metadata:     model.MetadataXML.ValueString(),
+		MetadataURL:  model.MetadataURL.ValueString(),
+		LoginEnabled: model.LoginEnabled.ValueBool(),
+		LoginLabel:   model.LoginLabel.ValueString(),
+	}
+
+	if model.AttributeMapping != nil {
+		config.Mapping = &client.SAMLAttributeMapping{
+			Email:             model.AttributeMapping.Email.ValueString(),
+			FirstName:         model.AttributeMapping.FirstName.ValueString(),
+			LastName:          model.AttributeMapping.LastName.ValueString(),
+			UserPrincipalName: model.AttributeMapping.UserPrincipalName.ValueString(),
+		}
+	}
+
+	return config
+}
+
+// optionalStringValue refreshes an optional attribute from the API without
+// turning an unset attribute into an empty string.
+func optionalStringValue(current types.String, remote string) types.String {
+	if current.IsNull() && remote == "" {
+		return current
+	}
+	return types.StringValue(remote)
+}
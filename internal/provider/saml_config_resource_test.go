@@ -0,0 +1,71 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// testAccSAMLMetadata is the metadata of an identity provider that is only
+// configured, never used to log in
+const testAccSAMLMetadata = `<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com/metadata">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</md:NameIDFormat>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>
+`
+
+func TestAccSAMLConfigResource(t *testing.T) {
+	testAccRequireFeature(t, "SAML", func(f *client.EnterpriseFeatures) bool { return f.SAML })
+	config := func(label string) string {
+		return `
+resource "n8n_saml_config" "test" {
+  metadata_xml = <<-EOT
+` + testAccSAMLMetadata + `EOT
+  login_label  = "` + label + `"
+
+  attribute_mapping = {
+    email               = "email"
+    first_name          = "firstName"
+    last_name           = "lastName"
+    user_principal_name = "upn"
+  }
+}
+`
+	}
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("n8n_saml_config.test", "entity_id"),
+		resource.TestCheckResourceAttrSet("n8n_saml_config.test", "return_url"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf-acc SSO"),
+				Check:  check,
+			},
+			{
+				Config:           config("tf-acc SSO renamed"),
+				ConfigPlanChecks: testAccExpectAction("n8n_saml_config.test", plancheck.ResourceActionUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					check,
+					resource.TestCheckResourceAttr("n8n_saml_config.test", "login_label", "tf-acc SSO renamed"),
+				),
+			},
+			{
+				ResourceName:      "n8n_saml_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &sourceControlPullResource{}
+	_ resource.ResourceWithConfigure = &sourceControlPullResource{}
+)
+
+// pulledResourceAttrTypes describes a workflow or credential imported by a pull.
+var pulledResourceAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+	"type": types.StringType,
+}
+
+// NewSourceControlPullResource is a helper function to simplify the provider implementation.
+func NewSourceControlPullResource() resource.Resource {
+	return &sourceControlPullResource{}
+}
+
+// sourceControlPullResource is the resource implementation.
+type sourceControlPullResource struct {
+	client client.SourceControlService
+}
+
+// sourceControlPullResourceModel maps the resource schema data.
+type sourceControlPullResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Force       types.Bool   `tfsdk:"force"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	Workflows   types.List   `tfsdk:"workflows"`
+	Credentials types.List   `tfsdk:"credentials"`
+}
+
+// Metadata returns the resource type name.
+func (r *sourceControlPullResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_control_pull"
+}
+
+// Schema defines the schema for the resource.
+func (r *sourceControlPullResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	pulledResourceAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Identifier of the imported resource",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the imported resource",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Credential type (empty for workflows)",
+			Computed:    true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Pulls the connected Git branch into the n8n instance when created. " +
+			"The pull runs again whenever triggers change; destroying the resource does not undo the pull. Requires n8n_source_control to be connected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Timestamp of the pull",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description: "Overwrite local changes on the instance instead of aborting the pull. Defaults to false.",
+				Optional:    true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that cause a new pull when changed, such as the commit SHA of the branch",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"workflows": schema.ListNestedAttribute{
+				Description: "Workflows imported by the pull",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pulledResourceAttributes,
+				},
+			},
+			"credentials": schema.ListNestedAttribute{
+				Description: "Credentials imported by the pull",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pulledResourceAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sourceControlPullResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SourceControlService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sourceControlPullResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &sourceControlPullResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan sourceControlPullResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.PullSourceControl(plan.Force.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Pulling n8n Source Control",
+			"Could not pull from the connected branch: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	plan.Workflows, diags = flattenPulledResources(result.Workflows)
+	resp.Diagnostics.Append(diags...)
+	plan.Credentials, diags = flattenPulledResources(result.Credentials)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sourceControlPullResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state sourceControlPullResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A pull is a one-off event with nothing to read back, so keep the
+	// existing state as-is.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only records the new force value; changing triggers replaces the resource instead.
+func (r *sourceControlPullResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan sourceControlPullResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the results of the last pull
+	var state sourceControlPullResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Workflows = state.Workflows
+	plan.Credentials = state.Credentials
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from Terraform state; the pulled resources stay on the instance.
+func (r *sourceControlPullResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// flattenPulledResources converts the pulled workflows or credentials into a list value.
+func flattenPulledResources(pulled []client.SourceControlPulledResource) (types.List, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: pulledResourceAttrTypes}
+
+	var diags diag.Diagnostics
+	elements := make([]attr.Value, 0, len(pulled))
+	for _, item := range pulled {
+		element, d := types.ObjectValue(pulledResourceAttrTypes, map[string]attr.Value{
+			"id":   types.StringValue(item.ID),
+			"name": types.StringValue(item.Name),
+			"type": types.StringValue(item.Type),
+		})
+		diags.Append(d...)
+		elements = append(elements, element)
+	}
+
+	list, d := types.ListValue(elementType, elements)
+	diags.Append(d...)
+
+	return list, diags
+}
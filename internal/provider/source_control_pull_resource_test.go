@@ -0,0 +1,32 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSourceControlPullResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceControlConfig(t) + `
+resource "n8n_source_control_pull" "test" {
+  force = true
+
+  triggers = {
+    run = "1"
+  }
+
+  depends_on = [n8n_source_control.test]
+}
+`,
+				Check: resource.TestCheckResourceAttrSet("n8n_source_control_pull.test", "id"),
+			},
+		},
+	})
+}
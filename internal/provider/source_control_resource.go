@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// sourceControlID is the fixed identifier of the instance-wide source control configuration.
+const sourceControlID = "source-control"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sourceControlResource{}
+	_ resource.ResourceWithConfigure   = &sourceControlResource{}
+	_ resource.ResourceWithImportState = &sourceControlResource{}
+)
+
+// NewSourceControlResource is a helper function to simplify the provider implementation.
+func NewSourceControlResource() resource.Resource {
+	return &sourceControlResource{}
+}
+
+// sourceControlResource is the resource implementation.
+type sourceControlResource struct {
+	client client.SourceControlService
+}
+
+// sourceControlResourceModel maps the resource schema data.
+type sourceControlResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	RepositoryURL    types.String `tfsdk:"repository_url"`
+	BranchName       types.String `tfsdk:"branch_name"`
+	BranchReadOnly   types.Bool   `tfsdk:"branch_read_only"`
+	BranchColor      types.String `tfsdk:"branch_color"`
+	KeyGeneratorType types.String `tfsdk:"key_generator_type"`
+	PublicKey        types.String `tfsdk:"public_key"`
+	Connected        types.Bool   `tfsdk:"connected"`
+}
+
+// Metadata returns the resource type name.
+func (r *sourceControlResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_control"
+}
+
+// Schema defines the schema for the resource.
+func (r *sourceControlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Git source control configuration of an n8n instance, used for environments. " +
+			"There is only one configuration per instance. Requires the n8n enterprise source control feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier (always 'source-control')",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_url": schema.StringAttribute{
+				Description: "SSH URL of the Git repository (e.g., 'git@github.com:org/n8n-environments.git')",
+				Required:    true,
+			},
+			"branch_name": schema.StringAttribute{
+				Description: "Branch to connect to. Leave unset on the first apply to only configure the repository and generate the SSH key, " +
+					"register public_key as a deploy key with your Git host, then set the branch to connect.",
+				Optional: true,
+			},
+			"branch_read_only": schema.BoolAttribute{
+				Description: "Whether the instance is prevented from pushing to the branch. Defaults to false.",
+				Optional:    true,
+			},
+			"branch_color": schema.StringAttribute{
+				Description: "Color used in the n8n UI to identify the environment (e.g., '#5296D6')",
+				Optional:    true,
+			},
+			"key_generator_type": schema.StringAttribute{
+				Description: "Type of the SSH key pair generated by n8n: 'ed25519' or 'rsa'. Changing this generates a new key pair.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ed25519", "rsa"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "Public SSH key that must be registered as a deploy key with the Git host",
+				Computed:    true,
+			},
+			"connected": schema.BoolAttribute{
+				Description: "Whether the instance is connected to the repository",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sourceControlResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SourceControlService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sourceControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &sourceControlResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan sourceControlResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preferences, err := r.client.SetSourceControlPreferences(sourceControlPreferencesFromModel(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Configuring n8n Source Control",
+			"Could not configure source control: "+err.Error()+
+				"\n\nIf the branch could not be connected, make sure the instance's public key is registered as a deploy key with your Git host.",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(sourceControlID)
+	setSourceControlComputed(&plan, preferences)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sourceControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &sourceControlResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state sourceControlResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preferences, err := r.client.GetSourceControlPreferences()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Source Control",
+			"Could not read source control preferences: "+err.Error(),
+		)
+		return
+	}
+
+	// Nothing configured anymore - Terraform will configure it again on next apply
+	if preferences.RepositoryURL == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only the ID is known after an import
+	imported := state.RepositoryURL.IsNull()
+	state.ID = types.StringValue(sourceControlID)
+	state.RepositoryURL = types.StringValue(preferences.RepositoryURL)
+	if preferences.BranchName != "" || !state.BranchName.IsNull() {
+		state.BranchName = types.StringValue(preferences.BranchName)
+	}
+	if preferences.BranchReadOnly || !state.BranchReadOnly.IsNull() {
+		state.BranchReadOnly = types.BoolValue(preferences.BranchReadOnly)
+	}
+	if preferences.BranchColor != "" && (!state.BranchColor.IsNull() || imported) {
+		state.BranchColor = types.StringValue(preferences.BranchColor)
+	}
+	setSourceControlComputed(&state, preferences)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *sourceControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &sourceControlResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan sourceControlResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state sourceControlResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new key type needs a new key pair before connecting with it
+	if !plan.KeyGeneratorType.IsUnknown() && !plan.KeyGeneratorType.Equal(state.KeyGeneratorType) {
+		if _, err := r.client.GenerateSourceControlKeyPair(plan.KeyGeneratorType.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Generating Source Control Key Pair",
+				"Could not generate a new "+plan.KeyGeneratorType.ValueString()+" key pair: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	preferences, err := r.client.SetSourceControlPreferences(sourceControlPreferencesFromModel(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating n8n Source Control",
+			"Could not update source control: "+err.Error()+licenseHint(err, "source control")+
+				"\n\nIf the branch could not be connected, make sure the instance's public key is registered as a deploy key with your Git host.",
+		)
+		return
+	}
+
+	setSourceControlComputed(&plan, preferences)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sourceControlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &sourceControlResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state sourceControlResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Disconnecting also discards the key pair, so a re-created configuration
+	// starts from a clean slate.
+	if err := r.client.DisconnectSourceControl(false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Disconnecting n8n Source Control",
+			"Could not disconnect source control: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *sourceControlResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is only one configuration per instance, so any import ID will do
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), sourceControlID)...)
+}
+
+// sourceControlPreferencesFromModel builds the API payload from the resource model.
+func sourceControlPreferencesFromModel(model *sourceControlResourceModel) *client.SourceControlPreferences {
+	return &client.SourceControlPreferences{
+		RepositoryURL:    model.RepositoryURL.ValueString(),
+		BranchName:       model.BranchName.ValueString(),
+		BranchReadOnly:   model.BranchReadOnly.ValueBool(),
+		BranchColor:      model.BranchColor.ValueString(),
+		KeyGeneratorType: model.KeyGeneratorType.ValueString(),
+	}
+}
+
+// setSourceControlComputed copies the server-managed values into the model.
+func setSourceControlComputed(model *sourceControlResourceModel, preferences *client.SourceControlPreferences) {
+	model.PublicKey = types.StringValue(preferences.PublicKey)
+	model.Connected = types.BoolValue(preferences.Connected)
+	if preferences.KeyGeneratorType != "" {
+		model.KeyGeneratorType = types.StringValue(preferences.KeyGeneratorType)
+	} else if model.KeyGeneratorType.IsUnknown() {
+		model.KeyGeneratorType = types.StringNull()
+	}
+}
@@ -0,0 +1,48 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// testAccSourceControlConfig connects the instance to the repository the
+// source control tests run against. Its deploy keys must accept the key n8n
+// generates, so the repository has to be prepared for the tests.
+func testAccSourceControlConfig(t *testing.T) string {
+	t.Helper()
+	repositoryURL := testAccRequireEnv(t, "N8N_ACC_SOURCE_CONTROL_REPOSITORY")
+	testAccRequireFeature(t, "source control", func(f *client.EnterpriseFeatures) bool { return f.SourceControl })
+
+	return fmt.Sprintf(`
+resource "n8n_source_control" "test" {
+  repository_url = %q
+  branch_name    = "main"
+}
+`, repositoryURL)
+}
+
+func TestAccSourceControlResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceControlConfig(t),
+				Check:  resource.TestCheckResourceAttrSet("n8n_source_control.test", "public_key"),
+			},
+			{
+				ResourceName:      "n8n_source_control.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// n8n reports the defaults of settings that are not configured
+				ImportStateVerifyIgnore: []string{"branch_color", "key_generator_type"},
+			},
+		},
+	})
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// timeoutsBlock returns the timeouts block shared by the resources talking to
+// slow instances.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	description := func(operation string) string {
+		return "How long to wait for the " + operation + " to finish, as a duration such as '30s' or '5m'. " +
+			"Replaces the 30 second limit on each request to the n8n API."
+	}
+
+	block, ok := timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: description("create"),
+		ReadDescription:   description("read"),
+		UpdateDescription: description("update"),
+		DeleteDescription: description("delete"),
+	}).(schema.SingleNestedBlock)
+	if ok {
+		block.Description = "Timeouts for the operations of the resource. Without a timeout, each request to the n8n API times out after 30 seconds."
+	}
+	return block
+}
+
+// withOperationTimeout returns a context that expires after timeout. A zero
+// timeout, which is what an operation without a configured timeout gets,
+// leaves each request to its own 30 second limit.
+func withOperationTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// withContext ties the requests of a client to the context of an operation.
+// Clients other than *client.Client, such as fakes in tests, are returned
+// unchanged.
+func withContext[T any](c T, ctx context.Context) T {
+	if n8nClient, ok := any(c).(*client.Client); ok && n8nClient != nil {
+		if bound, ok := any(n8nClient.WithContext(ctx)).(T); ok {
+			return bound
+		}
+	}
+	return c
+}
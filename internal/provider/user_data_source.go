@@ -3,9 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/pinotelio/terraform-provider-n8n/internal/client"
@@ -24,13 +28,15 @@ func NewUserDataSource() datasource.DataSource {
 
 // userDataSource is the data source implementation.
 type userDataSource struct {
-	client *client.Client
+	client client.UsersService
 }
 
 // userDataSourceModel maps the data source schema data.
 type userDataSourceModel struct {
 	ID        types.String `tfsdk:"id"`
 	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
 	Role      types.String `tfsdk:"role"`
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
@@ -46,14 +52,27 @@ func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 // Schema defines the schema for the data source.
 func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches an n8n user.",
+		Description: "Fetches an n8n user by ID or email address.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "User identifier",
-				Required:    true,
+				Description: "User identifier. Exactly one of id or email must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email")),
+				},
 			},
 			"email": schema.StringAttribute{
-				Description: "Email address of the user",
+				Description: "Email address of the user. Exactly one of id or email must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"first_name": schema.StringAttribute{
+				Description: "First name of the user, empty for pending users",
+				Computed:    true,
+			},
+			"last_name": schema.StringAttribute{
+				Description: "Last name of the user, empty for pending users",
 				Computed:    true,
 			},
 			"role": schema.StringAttribute{
@@ -86,12 +105,12 @@ func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.UsersService)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -102,6 +121,9 @@ func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 
 // Read refreshes the Terraform state with the latest data.
 func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &userDataSource{client: withContext(d.client, ctx)}
+
 	var state userDataSourceModel
 
 	// Read configuration
@@ -112,17 +134,33 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	// Get user from n8n
-	user, err := d.client.GetUser(state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading n8n User",
-			"Could not read n8n user ID "+state.ID.ValueString()+": "+err.Error(),
-		)
-		return
+	var user *client.User
+	var err error
+	if !state.Email.IsNull() {
+		user, err = d.findUserByEmail(state.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading n8n User",
+				"Could not find n8n user with email "+state.Email.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		user, err = d.client.GetUser(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading n8n User",
+				"Could not read n8n user ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
 	}
 
 	// Map response to state
+	state.ID = types.StringValue(user.ID)
 	state.Email = types.StringValue(user.Email)
+	state.FirstName = types.StringValue(user.FirstName)
+	state.LastName = types.StringValue(user.LastName)
 	state.Role = types.StringValue(user.GetRole())
 	state.IsOwner = types.BoolValue(user.IsOwner)
 	state.IsPending = types.BoolValue(user.IsPending)
@@ -136,3 +174,20 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 }
+
+// findUserByEmail returns the user with the given email address. Email
+// addresses are compared case-insensitively, as n8n stores them lowercased.
+func (d *userDataSource) findUserByEmail(email string) (*client.User, error) {
+	users, err := d.client.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range users {
+		if strings.EqualFold(users[i].Email, email) {
+			return &users[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no user has this email address")
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// setInvitationExpiry computes when the invitation of a pending user
+// expires under its invitation_ttl. n8n does not report an expiry itself, so
+// both values are null for users that are not pending or have no ttl.
+func setInvitationExpiry(model *userResourceModel, now time.Time) {
+	model.InvitationExpiresAt = types.StringNull()
+	model.InvitationExpired = types.BoolNull()
+
+	if !model.IsPending.ValueBool() || model.InvitedAt.IsNull() || model.InvitationTTL.IsNull() {
+		return
+	}
+
+	invitedAt, err := time.Parse(time.RFC3339, model.InvitedAt.ValueString())
+	if err != nil {
+		return
+	}
+	ttl, err := time.ParseDuration(model.InvitationTTL.ValueString())
+	if err != nil {
+		// Rejected by the validator at plan time
+		return
+	}
+
+	expiresAt := invitedAt.Add(ttl)
+	model.InvitationExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+	model.InvitationExpired = types.BoolValue(!now.Before(expiresAt))
+}
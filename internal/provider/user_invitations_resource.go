@@ -0,0 +1,405 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &userInvitationsResource{}
+	_ resource.ResourceWithConfigure = &userInvitationsResource{}
+)
+
+// NewUserInvitationsResource is a helper function to simplify the provider implementation.
+func NewUserInvitationsResource() resource.Resource {
+	return &userInvitationsResource{}
+}
+
+// userInvitationsResource is the resource implementation.
+type userInvitationsResource struct {
+	client client.UsersService
+}
+
+// userInvitationsResourceModel maps the resource schema data.
+type userInvitationsResourceModel struct {
+	ID    types.String                   `tfsdk:"id"`
+	Users map[string]userInvitationModel `tfsdk:"users"`
+
+	ForceRemoveFromStateOnError types.Bool `tfsdk:"force_remove_from_state_on_error"`
+}
+
+// userInvitationModel maps a single invited user, keyed by email address.
+type userInvitationModel struct {
+	Role            types.String `tfsdk:"role"`
+	ID              types.String `tfsdk:"id"`
+	InviteAcceptURL types.String `tfsdk:"invite_accept_url"`
+	IsPending       types.Bool   `tfsdk:"is_pending"`
+}
+
+// Metadata returns the resource type name.
+func (r *userInvitationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_invitations"
+}
+
+// Schema defines the schema for the resource.
+func (r *userInvitationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Invites a group of n8n users with a single API call. Users added later are invited together in one call, " +
+			"removed users are deleted, and role changes are applied in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Timestamp of the first invitation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"users": schema.MapNestedAttribute{
+				Description: "Users to invite, keyed by email address",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "Role of the user (e.g., 'global:admin', 'global:member'). The legacy names 'admin' and 'member' are translated to their global roles. " +
+								"Changing it requires the n8n enterprise advancedPermissions feature.",
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("global:member"),
+							Validators: []validator.String{
+								userRoleValidator(),
+							},
+						},
+						"id": schema.StringAttribute{
+							Description: "User identifier",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"invite_accept_url": schema.StringAttribute{
+							Description: "URL for the user to accept the invitation (only available after the invitation)",
+							Computed:    true,
+							Sensitive:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"is_pending": schema.BoolAttribute{
+							Description: "Whether the user has not accepted the invitation yet",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"force_remove_from_state_on_error": schema.BoolAttribute{
+				Description: "Remove users from state with a warning when n8n fails to delete them, e.g. on instances that do not allow deleting users through the API. " +
+					"The accounts then have to be deleted in the n8n UI. Defaults to false, which fails the apply or destroy instead.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userInvitationsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.UsersService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userInvitationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &userInvitationsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan userInvitationsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.inviteUsers(plan.Users, sortedKeys(plan.Users))...)
+	if !anyInvited(plan.Users) {
+		return
+	}
+	plan.ID = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	// Record whoever was invited, even if some invitations failed
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userInvitationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &userInvitationsResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state userInvitationsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for email, invitation := range state.Users {
+		// Users whose invitation failed have no ID; n8n also finds users by email
+		id := invitation.ID.ValueString()
+		if invitation.ID.IsNull() {
+			id = email
+		}
+
+		user, err := r.client.GetUser(id)
+		if err != nil {
+			// Drop users that were deleted outside of Terraform, or never
+			// invited, so that the next apply invites them again
+			if client.IsNotFound(err) {
+				delete(state.Users, email)
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading n8n User",
+				"Could not read n8n user "+email+": "+err.Error(),
+			)
+			return
+		}
+
+		// n8n often omits the role from GET /users; don't clobber a known role with "".
+		invitation.Role = userRoleValue(invitation.Role, user.GetRole())
+		invitation.ID = types.StringValue(user.ID)
+		invitation.IsPending = types.BoolValue(user.IsPending)
+		state.Users[email] = invitation
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update invites new users, deletes removed users and changes roles.
+func (r *userInvitationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &userInvitationsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan userInvitationsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state userInvitationsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete users that are no longer configured
+	removed := make(map[string]userInvitationModel)
+	for email, invitation := range state.Users {
+		if _, ok := plan.Users[email]; !ok {
+			removed[email] = invitation
+		}
+	}
+	for email, invitation := range r.deleteUsers(removed, plan.ForceRemoveFromStateOnError.ValueBool(), &resp.Diagnostics) {
+		// Keep the user in state so the deletion is retried
+		plan.Users[email] = invitation
+	}
+
+	// Change roles of existing users and collect new ones
+	var newEmails []string
+	for _, email := range sortedKeys(plan.Users) {
+		current, ok := state.Users[email]
+		if !ok || current.ID.IsNull() {
+			newEmails = append(newEmails, email)
+			continue
+		}
+		if _, ok := removed[email]; ok {
+			continue
+		}
+
+		invitation := plan.Users[email]
+		invitation.ID = current.ID
+		invitation.InviteAcceptURL = current.InviteAcceptURL
+		invitation.IsPending = current.IsPending
+		if normalizeUserRole(invitation.Role.ValueString()) != normalizeUserRole(current.Role.ValueString()) {
+			if _, err := r.client.UpdateUser(current.ID.ValueString(), &client.User{Role: normalizeUserRole(invitation.Role.ValueString())}); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating n8n User",
+					"Could not change the role of user "+email+": "+err.Error(),
+				)
+				invitation.Role = current.Role
+			}
+		}
+		plan.Users[email] = invitation
+	}
+
+	if len(newEmails) > 0 {
+		resp.Diagnostics.Append(r.inviteUsers(plan.Users, newEmails)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userInvitationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &userInvitationsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state userInvitationsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remaining := r.deleteUsers(state.Users, state.ForceRemoveFromStateOnError.ValueBool(), &resp.Diagnostics)
+	if len(remaining) > 0 {
+		// Keep the users that could not be deleted, so that the destroy can
+		// be run again
+		state.Users = remaining
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
+
+// deleteUsers deletes the given users and returns those that could not be
+// deleted. A failure is an error, unless force is set, in which case the
+// user is dropped with a warning.
+func (r *userInvitationsResource) deleteUsers(users map[string]userInvitationModel, force bool, diags *diag.Diagnostics) map[string]userInvitationModel {
+	remaining := make(map[string]userInvitationModel)
+	for _, email := range sortedKeys(users) {
+		if users[email].ID.IsNull() {
+			// The user was never invited
+			continue
+		}
+
+		err := r.client.DeleteUser(users[email].ID.ValueString())
+		switch {
+		case err == nil || client.IsNotFound(err):
+			// Deleted, or already deleted outside of Terraform
+		case force:
+			diags.AddWarning(
+				"Error Deleting n8n User",
+				fmt.Sprintf("Could not delete user %s via API: %s. The user may need to be deleted manually through the n8n UI. The user will be removed from Terraform state.", email, err.Error()),
+			)
+		default:
+			diags.AddError(
+				"Error Deleting n8n User",
+				fmt.Sprintf("Could not delete user %s via API: %s. Delete the user through the n8n UI and apply again, or set force_remove_from_state_on_error to remove it from state anyway.", email, err.Error()),
+			)
+			remaining[email] = users[email]
+		}
+	}
+	return remaining
+}
+
+// inviteUsers invites the given emails with one API call and records the
+// results in users. Users that could not be invited are reported as errors
+// and kept without an ID, so that Read looks them up by email and the next
+// apply invites them again if n8n does not know them.
+func (r *userInvitationsResource) inviteUsers(users map[string]userInvitationModel, emails []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	invitations := make([]client.UserInvitation, 0, len(emails))
+	for _, email := range emails {
+		invitations = append(invitations, client.UserInvitation{
+			Email: email,
+			Role:  normalizeUserRole(users[email].Role.ValueString()),
+		})
+	}
+
+	results, err := r.client.InviteUsers(invitations)
+	if err != nil {
+		diags.AddError(
+			"Error Inviting n8n Users",
+			"Could not invite users, unexpected error: "+err.Error(),
+		)
+		for _, email := range emails {
+			users[email] = notInvited(users[email])
+		}
+		return diags
+	}
+
+	for i, result := range results {
+		email := emails[i]
+		if result.Error != "" {
+			diags.AddError(
+				"Error Inviting n8n User",
+				"Could not invite "+email+", the invitation will be retried on the next apply: "+result.Error,
+			)
+			users[email] = notInvited(users[email])
+			continue
+		}
+
+		invitation := users[email]
+		invitation.ID = types.StringValue(result.User.ID)
+		invitation.InviteAcceptURL = types.StringValue(result.User.InviteAcceptURL)
+		invitation.IsPending = types.BoolValue(true)
+		users[email] = invitation
+	}
+
+	return diags
+}
+
+// notInvited records that the invitation of a user failed
+func notInvited(invitation userInvitationModel) userInvitationModel {
+	invitation.ID = types.StringNull()
+	invitation.InviteAcceptURL = types.StringNull()
+	invitation.IsPending = types.BoolNull()
+	return invitation
+}
+
+// anyInvited reports whether at least one of the users was invited
+func anyInvited(users map[string]userInvitationModel) bool {
+	for _, invitation := range users {
+		if !invitation.ID.IsNull() && !invitation.ID.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of a map in a stable order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,50 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccUserInvitationsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "n8n_user_invitations" "test" {
+  users = {
+    "tf-acc-invited-1@example.com" = {}
+    "tf-acc-invited-2@example.com" = {}
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_user_invitations.test", "users.tf-acc-invited-1@example.com.id"),
+					resource.TestCheckResourceAttrSet("n8n_user_invitations.test", "users.tf-acc-invited-2@example.com.id"),
+				),
+			},
+			{
+				Config: `
+resource "n8n_user_invitations" "test" {
+  users = {
+    "tf-acc-invited-1@example.com" = {}
+    "tf-acc-invited-2@example.com" = {}
+    "tf-acc-invited-3@example.com" = {}
+  }
+}
+`,
+				ConfigPlanChecks: testAccExpectAction("n8n_user_invitations.test", plancheck.ResourceActionUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_user_invitations.test", "users.tf-acc-invited-1@example.com.id"),
+					resource.TestCheckResourceAttrSet("n8n_user_invitations.test", "users.tf-acc-invited-2@example.com.id"),
+					resource.TestCheckResourceAttrSet("n8n_user_invitations.test", "users.tf-acc-invited-3@example.com.id"),
+				),
+			},
+		},
+	})
+}
@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,16 +15,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/pinotelio/terraform-provider-n8n/internal/client"
 )
 
+// acceptancePollInterval is how often a pending user is checked while waiting
+// for them to accept their invitation.
+const acceptancePollInterval = 10 * time.Second
+
+// defaultAcceptanceTimeout is how long to wait for a user to accept their
+// invitation when acceptance_timeout_seconds is not set.
+const defaultAcceptanceTimeout = time.Hour
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithIdentity    = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
 )
 
 // NewUserResource is a helper function to simplify the provider implementation.
@@ -31,19 +45,45 @@ func NewUserResource() resource.Resource {
 
 // userResource is the resource implementation.
 type userResource struct {
-	client *client.Client
+	client userResourceClient
+}
+
+// userResourceClient is the part of the n8n API the user resource uses.
+type userResourceClient interface {
+	client.Endpoints
+	client.InstanceService
+	client.ProjectsService
+	client.RolesService
+	client.UsersService
 }
 
 // userResourceModel maps the resource schema data.
 type userResourceModel struct {
 	ID              types.String `tfsdk:"id"`
 	Email           types.String `tfsdk:"email"`
+	FirstName       types.String `tfsdk:"first_name"`
+	LastName        types.String `tfsdk:"last_name"`
 	Role            types.String `tfsdk:"role"`
 	CreatedAt       types.String `tfsdk:"created_at"`
 	UpdatedAt       types.String `tfsdk:"updated_at"`
 	InviteAcceptURL types.String `tfsdk:"invite_accept_url"`
 	IsOwner         types.Bool   `tfsdk:"is_owner"`
 	IsPending       types.Bool   `tfsdk:"is_pending"`
+
+	ResendInvitation    types.String `tfsdk:"resend_invitation"`
+	InvitedAt           types.String `tfsdk:"invited_at"`
+	InvitationTTL       types.String `tfsdk:"invitation_ttl"`
+	InvitationExpiresAt types.String `tfsdk:"invitation_expires_at"`
+	InvitationExpired   types.Bool   `tfsdk:"invitation_expired"`
+
+	WaitForAcceptance        types.Bool  `tfsdk:"wait_for_acceptance"`
+	AcceptanceTimeoutSeconds types.Int64 `tfsdk:"acceptance_timeout_seconds"`
+
+	ForceRemoveFromStateOnError types.Bool   `tfsdk:"force_remove_from_state_on_error"`
+	TransferToUserID            types.String `tfsdk:"transfer_to_user_id"`
+	TransferToProjectID         types.String `tfsdk:"transfer_to_project_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -52,7 +92,7 @@ func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an n8n user.",
 		Attributes: map[string]schema.Attribute{
@@ -70,11 +110,29 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"role": schema.StringAttribute{
-				Description: "Role of the user (e.g., 'global:owner', 'global:admin', 'global:member'). Changing it requires the n8n enterprise advancedPermissions feature.",
-				Optional:    true,
+			"first_name": schema.StringAttribute{
+				Description: "First name of the user, empty until the user accepted the invitation. Users set their name themselves; the n8n API cannot change it.",
 				Computed:    true,
-				Default:     stringdefault.StaticString("global:member"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				Description: "Last name of the user, empty until the user accepted the invitation. Users set their name themselves; the n8n API cannot change it.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the user (e.g., 'global:admin', 'global:member'). The legacy names 'admin' and 'member' are translated to their global roles. " +
+					"The role is checked against the global roles of the instance at plan time. Assigning 'global:admin' or changing a role requires the n8n enterprise advancedPermissions feature.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("global:member"),
+				Validators: []validator.String{
+					userRoleValidator(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -108,28 +166,144 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"invite_accept_url": schema.StringAttribute{
-				Description: "URL for the user to accept the invitation (only available after user creation)",
+				Description: "URL for the user to accept the invitation (only available after user creation or a resend)",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resend_invitation": schema.StringAttribute{
+				Description: "Arbitrary value that invites the user again when it changes, while the user is still pending, e.g. a date or counter. " +
+					"The new invitation replaces invite_accept_url and invited_at.",
+				Optional: true,
+			},
+			"wait_for_acceptance": schema.BoolAttribute{
+				Description: "Wait after creating the user until they accepted the invitation, so that resources depending on the user see an activated account. " +
+					"The apply fails if the invitation is not accepted within acceptance_timeout_seconds.",
+				Optional: true,
+			},
+			"acceptance_timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the invitation to be accepted when wait_for_acceptance is set. Defaults to 3600.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"force_remove_from_state_on_error": schema.BoolAttribute{
+				Description: "Remove the user from state with a warning when n8n fails to delete it, e.g. on instances that do not allow deleting users through the API. " +
+					"The account then has to be deleted in the n8n UI. Defaults to false, which fails the destroy instead.",
+				Optional: true,
+			},
+			"transfer_to_user_id": schema.StringAttribute{
+				Description: "ID of the user whose personal project receives the workflows and credentials of this user when it is destroyed. " +
+					"Without a transfer target, n8n deletes them together with the user. Conflicts with transfer_to_project_id.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("transfer_to_project_id")),
+				},
+			},
+			"transfer_to_project_id": schema.StringAttribute{
+				Description: "ID of the project that receives the workflows and credentials of this user when it is destroyed. Conflicts with transfer_to_user_id.",
+				Optional:    true,
+			},
+			"invited_at": schema.StringAttribute{
+				Description: "Time the provider last invited the user, as an RFC 3339 timestamp. For pending users that were imported or created by earlier provider versions, this is created_at.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"invitation_ttl": schema.StringAttribute{
+				Description: "How long an invitation stays valid, as a duration such as '168h', used to compute invitation_expires_at and invitation_expired.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationPattern, "must be a duration such as '72h' or '168h'"),
+				},
+			},
+			"invitation_expires_at": schema.StringAttribute{
+				Description: "Time the invitation of a pending user expires under invitation_ttl, as an RFC 3339 timestamp. n8n does not report an expiry itself.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_expired": schema.BoolAttribute{
+				Description: "Whether the invitation of a pending user is past invitation_expires_at, as of the last refresh. Change resend_invitation to invite the user again.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *userResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("User identifier")
+}
+
+// ModifyPlan checks new roles against the instance and marks the values a
+// role change or a new invitation will replace as unknown.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleChanged := !plan.Role.IsUnknown() && normalizeUserRole(plan.Role.ValueString()) != normalizeUserRole(state.Role.ValueString())
+	if roleChanged && r.client != nil {
+		resp.Diagnostics.Append(validateUserRole(ctx, r.client, plan.Role.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Nothing to compare on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if roleChanged {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())...)
+	}
+
+	resend := !plan.ResendInvitation.IsNull() && !plan.ResendInvitation.Equal(state.ResendInvitation) && state.IsPending.ValueBool()
+	if resend {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("invite_accept_url"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("invited_at"), types.StringUnknown())...)
+	}
+	if resend || !plan.InvitationTTL.Equal(state.InvitationTTL) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("invitation_expires_at"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("invitation_expired"), types.BoolUnknown())...)
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(userResourceClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -148,10 +322,20 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &userResource{client: withContext(r.client, opCtx)}
+
 	// Create new user
 	user := &client.User{
 		Email: plan.Email.ValueString(),
-		Role:  plan.Role.ValueString(),
+		Role:  normalizeUserRole(plan.Role.ValueString()),
 	}
 
 	createdUser, err := r.client.CreateUser(user)
@@ -166,12 +350,45 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(createdUser.ID)
 	plan.Email = types.StringValue(createdUser.Email)
-	plan.Role = types.StringValue(createdUser.GetRole())
+	plan.FirstName = types.StringValue(createdUser.FirstName)
+	plan.LastName = types.StringValue(createdUser.LastName)
+	plan.Role = userRoleValue(plan.Role, createdUser.GetRole())
 	plan.IsOwner = types.BoolValue(createdUser.IsOwner)
 	plan.IsPending = types.BoolValue(createdUser.IsPending)
 	plan.CreatedAt = types.StringValue(createdUser.CreatedAt)
 	plan.UpdatedAt = types.StringValue(createdUser.UpdatedAt)
 	plan.InviteAcceptURL = types.StringValue(createdUser.InviteAcceptURL)
+	plan.InvitedAt = types.StringNull()
+	if createdUser.IsPending {
+		plan.InvitedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+	setInvitationExpiry(&plan, time.Now())
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+
+	// Hold dependent resources back until the account is activated
+	if plan.WaitForAcceptance.ValueBool() && createdUser.IsPending {
+		timeout := defaultAcceptanceTimeout
+		if !plan.AcceptanceTimeoutSeconds.IsNull() {
+			timeout = time.Duration(plan.AcceptanceTimeoutSeconds.ValueInt64()) * time.Second
+		}
+
+		acceptedUser, err := r.waitForAcceptance(opCtx, createdUser.ID, timeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Waiting for n8n User",
+				"User "+plan.Email.ValueString()+" was invited but did not accept the invitation: "+err.Error(),
+			)
+			// Keep the invited user in state so it is not orphaned
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+
+		plan.IsPending = types.BoolValue(acceptedUser.IsPending)
+		plan.FirstName = types.StringValue(acceptedUser.FirstName)
+		plan.LastName = types.StringValue(acceptedUser.LastName)
+		plan.UpdatedAt = types.StringValue(acceptedUser.UpdatedAt)
+		setInvitationExpiry(&plan, time.Now())
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -181,6 +398,29 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 }
 
+// waitForAcceptance polls a user until they are no longer pending or the timeout expires.
+func (r *userResource) waitForAcceptance(ctx context.Context, id string, timeout time.Duration) (*client.User, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		user, err := r.client.GetUser(id)
+		if err != nil {
+			return nil, err
+		}
+		if !user.IsPending {
+			return user, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("user still pending after %s", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(acceptancePollInterval):
+		}
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
@@ -191,11 +431,21 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := state.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &userResource{client: withContext(r.client, opCtx)}
+
 	// Get refreshed user value from n8n
 	user, err := r.client.GetUser(state.ID.ValueString())
 	if err != nil {
 		// Check if the user was deleted outside of Terraform (404 error)
-		if strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			// Remove from state - Terraform will recreate it on next apply
 			resp.State.RemoveResource(ctx)
 			return
@@ -210,14 +460,21 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Overwrite items with refreshed state
 	state.Email = types.StringValue(user.Email)
+	state.FirstName = types.StringValue(user.FirstName)
+	state.LastName = types.StringValue(user.LastName)
 	// n8n often omits the role from GET /users; don't clobber a known role with "".
-	if role := user.GetRole(); role != "" {
-		state.Role = types.StringValue(role)
-	}
+	state.Role = userRoleValue(state.Role, user.GetRole())
 	state.IsOwner = types.BoolValue(user.IsOwner)
 	state.IsPending = types.BoolValue(user.IsPending)
 	state.CreatedAt = types.StringValue(user.CreatedAt)
 	state.UpdatedAt = types.StringValue(user.UpdatedAt)
+	// Not set after an import or by earlier provider versions
+	if state.InvitedAt.IsNull() && user.IsPending {
+		state.InvitedAt = types.StringValue(user.CreatedAt)
+	}
+	setInvitationExpiry(&state, time.Now())
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -237,31 +494,90 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &userResource{client: withContext(r.client, opCtx)}
+
+	// Get current state
+	var state userResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update existing user
 	// Note: Only role can be updated via the n8n API
-	user := &client.User{
-		Role: plan.Role.ValueString(),
+	if normalizeUserRole(plan.Role.ValueString()) != normalizeUserRole(state.Role.ValueString()) {
+		user := &client.User{
+			Role: normalizeUserRole(plan.Role.ValueString()),
+		}
+
+		updatedUser, err := r.client.UpdateUser(plan.ID.ValueString(), user)
+		if err != nil {
+			detail := "Could not update user: " + err.Error()
+			if client.IsForbidden(err) {
+				detail = "Changing a user's role requires the n8n enterprise advancedPermissions feature. " + err.Error()
+			}
+			resp.Diagnostics.AddError("Error Updating n8n User", detail)
+			return
+		}
+
+		// Update resource state with refreshed data from API
+		plan.Email = types.StringValue(updatedUser.Email)
+		plan.Role = userRoleValue(plan.Role, updatedUser.GetRole())
+		plan.IsOwner = types.BoolValue(updatedUser.IsOwner)
+		plan.IsPending = types.BoolValue(updatedUser.IsPending)
+		plan.CreatedAt = types.StringValue(updatedUser.CreatedAt)
+		plan.UpdatedAt = types.StringValue(updatedUser.UpdatedAt)
 	}
 
-	updatedUser, err := r.client.UpdateUser(plan.ID.ValueString(), user)
-	if err != nil {
-		detail := "Could not update user: " + err.Error()
-		if strings.Contains(err.Error(), "advancedPermissions") || strings.Contains(err.Error(), "403") {
-			detail = "Changing a user's role requires the n8n enterprise advancedPermissions feature. " + err.Error()
+	// Invite pending users again when resend_invitation changes
+	if !plan.ResendInvitation.IsNull() && !plan.ResendInvitation.Equal(state.ResendInvitation) {
+		if state.IsPending.ValueBool() {
+			inviteAcceptURL, err := r.client.ResendInvitation(plan.Email.ValueString(), normalizeUserRole(plan.Role.ValueString()))
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Resending n8n Invitation",
+					"Could not invite user "+plan.Email.ValueString()+" again: "+err.Error(),
+				)
+				return
+			}
+			plan.InviteAcceptURL = types.StringValue(inviteAcceptURL)
+			plan.InvitedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+		} else {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("resend_invitation"),
+				"Invitation Not Resent",
+				"User "+plan.Email.ValueString()+" already accepted the invitation, so it was not sent again.",
+			)
 		}
-		resp.Diagnostics.AddError("Error Updating n8n User", detail)
-		return
 	}
 
-	// Update resource state with refreshed data from API
-	plan.Email = types.StringValue(updatedUser.Email)
-	if role := updatedUser.GetRole(); role != "" {
-		plan.Role = types.StringValue(role)
+	// Not known after an import or an upgrade from earlier provider versions
+	if plan.FirstName.IsUnknown() {
+		plan.FirstName = state.FirstName
+	}
+	if plan.LastName.IsUnknown() {
+		plan.LastName = state.LastName
 	}
-	plan.IsOwner = types.BoolValue(updatedUser.IsOwner)
-	plan.IsPending = types.BoolValue(updatedUser.IsPending)
-	plan.CreatedAt = types.StringValue(updatedUser.CreatedAt)
-	plan.UpdatedAt = types.StringValue(updatedUser.UpdatedAt)
+	if plan.InviteAcceptURL.IsUnknown() {
+		plan.InviteAcceptURL = types.StringNull()
+	}
+	if plan.InvitedAt.IsUnknown() {
+		plan.InvitedAt = state.InvitedAt
+	}
+	if plan.InvitationExpiresAt.IsUnknown() || plan.InvitationExpired.IsUnknown() {
+		setInvitationExpiry(&plan, time.Now())
+	}
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -280,21 +596,69 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &userResource{client: withContext(r.client, opCtx)}
+
+	// Find the project that takes over the work of the user
+	var err error
+	transferProjectID := state.TransferToProjectID.ValueString()
+	if !state.TransferToUserID.IsNull() {
+		transferProjectID, err = r.client.PersonalProjectID(state.TransferToUserID.ValueString())
+		if err != nil {
+			err = fmt.Errorf("could not find the personal project of user %s to transfer to: %w", state.TransferToUserID.ValueString(), err)
+		}
+	}
+
 	// Delete existing user
-	err := r.client.DeleteUser(state.ID.ValueString())
-	if err != nil {
+	deleted := false
+	switch {
+	case err != nil:
+		// Keep the user rather than deleting their work with them
+	case transferProjectID != "":
+		err = r.client.DeleteUserWithTransfer(state.ID.ValueString(), transferProjectID)
+		// A 404 may also mean that the endpoint is missing, so check the user
+		if err != nil && client.IsNotFound(err) {
+			if _, getErr := r.client.GetUser(state.ID.ValueString()); getErr != nil && client.IsNotFound(getErr) {
+				deleted = true
+			}
+		}
+	default:
+		err = r.client.DeleteUser(state.ID.ValueString())
+		deleted = err != nil && client.IsNotFound(err)
+	}
+
+	switch {
+	case err == nil || deleted:
+		// Deleted, or already deleted outside of Terraform
+	case state.ForceRemoveFromStateOnError.ValueBool():
 		// Some n8n instances may not support user deletion via API
 		// In this case, we log a warning but still remove from state
 		resp.Diagnostics.AddWarning(
 			"Error Deleting n8n User",
 			fmt.Sprintf("Could not delete user %s via API: %s. The user may need to be deleted manually through the n8n UI. The resource will be removed from Terraform state.", state.ID.ValueString(), err.Error()),
 		)
-		// Don't return - allow state to be removed even if API deletion fails
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting n8n User",
+			fmt.Sprintf("Could not delete user %s via API: %s. Delete the user through the n8n UI and run the destroy again, or set force_remove_from_state_on_error to remove it from state anyway.", state.ID.ValueString(), err.Error()),
+		)
 	}
 }
 
 // ImportState imports the resource state.
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	id, diags := importedResourceID(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -0,0 +1,39 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "n8n_user" "test" {
+  email = "tf-acc-user@example.com"
+  role  = "global:member"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_user.test", "email", "tf-acc-user@example.com"),
+					resource.TestCheckResourceAttr("n8n_user.test", "role", "global:member"),
+					resource.TestCheckResourceAttrSet("n8n_user.test", "id"),
+					resource.TestCheckResourceAttrSet("n8n_user.test", "invite_accept_url"),
+				),
+			},
+			{
+				ResourceName:      "n8n_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The invitation is only known to the state that sent it
+				ImportStateVerifyIgnore: []string{"invite_accept_url", "invited_at", "invitation_expires_at"},
+			},
+		},
+	})
+}
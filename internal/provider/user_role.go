@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// legacyUserRoles maps the role names of n8n versions before 1.0 to the
+// global roles that replaced them.
+var legacyUserRoles = map[string]string{
+	"owner":  "global:owner",
+	"admin":  "global:admin",
+	"member": "global:member",
+}
+
+// userRolePattern matches global role slugs and the legacy role names.
+var userRolePattern = regexp.MustCompile(`^(global:[A-Za-z0-9_-]+|owner|admin|member)$`)
+
+// userRoleValidator checks that a value looks like a global role.
+func userRoleValidator() validator.String {
+	return stringvalidator.RegexMatches(
+		userRolePattern,
+		"must be a global role such as 'global:member' or 'global:admin'",
+	)
+}
+
+// normalizeUserRole translates legacy role names to global roles.
+func normalizeUserRole(role string) string {
+	if globalRole, ok := legacyUserRoles[role]; ok {
+		return globalRole
+	}
+	return role
+}
+
+// userRoleValue returns the role to record for a user. The configured value
+// is kept when n8n reports the same role, so legacy role names in the
+// configuration do not show up as changes.
+func userRoleValue(configured types.String, role string) types.String {
+	if role == "" || role == normalizeUserRole(configured.ValueString()) {
+		return configured
+	}
+	return types.StringValue(role)
+}
+
+// userRoleClient is the part of the n8n API user roles are checked against.
+type userRoleClient interface {
+	client.RolesService
+	client.InstanceService
+}
+
+// validateUserRole checks a role against the global roles and licensed
+// features of the instance. Checks the instance cannot answer, e.g. because
+// it predates the roles endpoint, are skipped.
+func validateUserRole(ctx context.Context, c userRoleClient, role string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	role = normalizeUserRole(role)
+
+	if role == "global:owner" {
+		diags.AddAttributeError(
+			path.Root("role"),
+			"Invalid n8n User Role",
+			"Users cannot be invited as global:owner; an instance has a single owner, which is created when the instance is set up.",
+		)
+		return diags
+	}
+
+	if roles, err := withContext(c, ctx).ListRoles("global"); err == nil && len(roles) > 0 {
+		slugs := make([]string, 0, len(roles))
+		for _, r := range roles {
+			if r.Slug == role {
+				slugs = nil
+				break
+			}
+			slugs = append(slugs, r.Slug)
+		}
+		if slugs != nil {
+			sort.Strings(slugs)
+			diags.AddAttributeError(
+				path.Root("role"),
+				"Unsupported n8n User Role",
+				"The n8n instance does not have the global role "+role+". Supported roles are: "+strings.Join(slugs, ", ")+".",
+			)
+			return diags
+		}
+	}
+
+	if role == "global:admin" {
+		if info, err := withContext(c, ctx).GetInstanceInfo(); err == nil && !info.Enterprise.AdvancedPermissions {
+			diags.AddAttributeError(
+				path.Root("role"),
+				"n8n Enterprise Feature Required",
+				"The global:admin role requires the n8n enterprise advancedPermissions feature, which is not licensed on this instance. "+
+					"Use global:member, or activate a license that includes advanced permissions.",
+			)
+		}
+	}
+
+	return diags
+}
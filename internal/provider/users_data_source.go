@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client client.UsersService
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	Users []userSummaryModel `tfsdk:"users"`
+}
+
+// userSummaryModel maps a single user of the list.
+type userSummaryModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Role      types.String `tfsdk:"role"`
+	IsOwner   types.Bool   `tfsdk:"is_owner"`
+	IsPending types.Bool   `tfsdk:"is_pending"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all users of an n8n instance, including pending invitations.",
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListNestedAttribute{
+				Description: "Users of the instance",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "User identifier",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address of the user",
+							Computed:    true,
+						},
+						"first_name": schema.StringAttribute{
+							Description: "First name of the user, empty for pending users",
+							Computed:    true,
+						},
+						"last_name": schema.StringAttribute{
+							Description: "Last name of the user, empty for pending users",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the user",
+							Computed:    true,
+						},
+						"is_owner": schema.BoolAttribute{
+							Description: "Whether the user is an owner",
+							Computed:    true,
+						},
+						"is_pending": schema.BoolAttribute{
+							Description: "Whether the user account is pending activation",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the user was created",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Timestamp when the user was last updated",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.UsersService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &usersDataSource{client: withContext(d.client, ctx)}
+
+	var state usersDataSourceModel
+
+	users, err := d.client.ListUsers()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Users",
+			"Could not list n8n users: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.Users = make([]userSummaryModel, 0, len(users))
+	for _, user := range users {
+		state.Users = append(state.Users, userSummaryModel{
+			ID:        types.StringValue(user.ID),
+			Email:     types.StringValue(user.Email),
+			FirstName: types.StringValue(user.FirstName),
+			LastName:  types.StringValue(user.LastName),
+			Role:      types.StringValue(user.GetRole()),
+			IsOwner:   types.BoolValue(user.IsOwner),
+			IsPending: types.BoolValue(user.IsPending),
+			CreatedAt: types.StringValue(user.CreatedAt),
+			UpdatedAt: types.StringValue(user.UpdatedAt),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
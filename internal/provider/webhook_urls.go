@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// webhookNodePrefixes maps the node types that receive HTTP requests to the
+// production and test URL prefixes n8n serves them under.
+var webhookNodePrefixes = map[string][2]string{
+	"n8n-nodes-base.webhook":     {"webhook", "webhook-test"},
+	"n8n-nodes-base.formTrigger": {"form", "form-test"},
+}
+
+// webhookURLAttrTypes are the attribute types of a webhook_urls element.
+var webhookURLAttrTypes = map[string]attr.Type{
+	"node_name":      types.StringType,
+	"method":         types.StringType,
+	"production_url": types.StringType,
+	"test_url":       types.StringType,
+}
+
+// webhookURLsDescription documents the webhook_urls attribute.
+const webhookURLsDescription = "URLs of the webhook and form trigger nodes of the workflow. " +
+	"The production URL only responds while the workflow is active; the test URL only while it is listening in the editor."
+
+// webhookURLsSchemaAttribute returns the resource schema of webhook_urls.
+func webhookURLsSchemaAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: webhookURLsDescription,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"node_name": schema.StringAttribute{
+					Description: "Name of the node",
+					Computed:    true,
+				},
+				"method": schema.StringAttribute{
+					Description: "HTTP method the node listens for",
+					Computed:    true,
+				},
+				"production_url": schema.StringAttribute{
+					Description: "URL of the node while the workflow is active",
+					Computed:    true,
+				},
+				"test_url": schema.StringAttribute{
+					Description: "URL of the node while testing the workflow in the editor",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// webhookURLsDataSourceSchemaAttribute returns the data source schema of webhook_urls.
+func webhookURLsDataSourceSchemaAttribute() datasourceschema.ListNestedAttribute {
+	return datasourceschema.ListNestedAttribute{
+		Description: webhookURLsDescription,
+		Computed:    true,
+		NestedObject: datasourceschema.NestedAttributeObject{
+			Attributes: map[string]datasourceschema.Attribute{
+				"node_name": datasourceschema.StringAttribute{
+					Description: "Name of the node",
+					Computed:    true,
+				},
+				"method": datasourceschema.StringAttribute{
+					Description: "HTTP method the node listens for",
+					Computed:    true,
+				},
+				"production_url": datasourceschema.StringAttribute{
+					Description: "URL of the node while the workflow is active",
+					Computed:    true,
+				},
+				"test_url": datasourceschema.StringAttribute{
+					Description: "URL of the node while testing the workflow in the editor",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// workflowWebhookURLs builds the webhook_urls value from the workflow nodes.
+func workflowWebhookURLs(baseURL string, nodes []interface{}) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: webhookURLAttrTypes}
+	elements := []attr.Value{}
+
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		nodeType, _ := node["type"].(string)
+		prefixes, ok := webhookNodePrefixes[nodeType]
+		if !ok {
+			continue
+		}
+
+		name, _ := node["name"].(string)
+		webhookID, _ := node["webhookId"].(string)
+		parameters, _ := node["parameters"].(map[string]interface{})
+		webhookPath, _ := parameters["path"].(string)
+		webhookPath = strings.Trim(webhookPath, "/")
+
+		// n8n serves paths without a custom value, and paths with route
+		// parameters, under the webhook ID
+		switch {
+		case webhookPath == "":
+			webhookPath = webhookID
+		case strings.Contains(webhookPath, ":") && webhookID != "":
+			webhookPath = webhookID + "/" + webhookPath
+		}
+		if webhookPath == "" {
+			continue
+		}
+
+		method, _ := parameters["httpMethod"].(string)
+		if method == "" {
+			method = "GET"
+		}
+
+		element, diags := types.ObjectValue(webhookURLAttrTypes, map[string]attr.Value{
+			"node_name":      types.StringValue(name),
+			"method":         types.StringValue(method),
+			"production_url": types.StringValue(baseURL + "/" + prefixes[0] + "/" + webhookPath),
+			"test_url":       types.StringValue(baseURL + "/" + prefixes[1] + "/" + webhookPath),
+		})
+		if diags.HasError() {
+			return types.ListNull(elemType), diags
+		}
+		elements = append(elements, element)
+	}
+
+	return types.ListValue(elemType, elements)
+}
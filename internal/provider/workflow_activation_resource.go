@@ -3,13 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/pinotelio/terraform-provider-n8n/internal/client"
@@ -20,6 +22,15 @@ var (
 	_ resource.Resource                = &workflowActivationResource{}
 	_ resource.ResourceWithConfigure   = &workflowActivationResource{}
 	_ resource.ResourceWithImportState = &workflowActivationResource{}
+	_ resource.ResourceWithModifyPlan  = &workflowActivationResource{}
+)
+
+// Behaviors of n8n_workflow_activation when a workflow was activated or
+// deactivated outside of Terraform.
+const (
+	activationDriftReactivate = "reactivate"
+	activationDriftIgnore     = "ignore"
+	activationDriftError      = "error"
 )
 
 // NewWorkflowActivationResource is a helper function to simplify the provider implementation.
@@ -29,7 +40,7 @@ func NewWorkflowActivationResource() resource.Resource {
 
 // workflowActivationResource is the resource implementation.
 type workflowActivationResource struct {
-	client *client.Client
+	client client.WorkflowsService
 }
 
 // workflowActivationResourceModel maps the resource schema data.
@@ -37,6 +48,7 @@ type workflowActivationResourceModel struct {
 	ID         types.String `tfsdk:"id"`
 	WorkflowID types.String `tfsdk:"workflow_id"`
 	Active     types.Bool   `tfsdk:"active"`
+	OnDrift    types.String `tfsdk:"on_drift"`
 }
 
 // Metadata returns the resource type name.
@@ -67,6 +79,17 @@ func (r *workflowActivationResource) Schema(_ context.Context, _ resource.Schema
 				Description: "Whether the workflow should be active. Note: Workflows must have at least one trigger, poller, or webhook node to be activated.",
 				Required:    true,
 			},
+			"on_drift": schema.StringAttribute{
+				Description: "What to do when the workflow was activated or deactivated outside of Terraform: " +
+					"'reactivate' reports the change so the next apply restores the configured state, " +
+					"'ignore' keeps the state as it is, and 'error' fails the refresh. Defaults to 'reactivate'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(activationDriftReactivate),
+				Validators: []validator.String{
+					stringvalidator.OneOf(activationDriftReactivate, activationDriftIgnore, activationDriftError),
+				},
+			},
 		},
 	}
 }
@@ -77,12 +100,12 @@ func (r *workflowActivationResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.WorkflowsService)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -91,8 +114,64 @@ func (r *workflowActivationResource) Configure(_ context.Context, req resource.C
 	r.client = client
 }
 
+// ModifyPlan checks that a workflow about to be activated has a node n8n can
+// start it from, so that the failure is reported by terraform plan.
+func (r *workflowActivationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Cancel the requests of this operation together with it
+	r = &workflowActivationResource{client: withContext(r.client, ctx)}
+
+	var plan workflowActivationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The workflow may not exist yet
+	if plan.WorkflowID.IsUnknown() || plan.Active.IsUnknown() || !plan.Active.ValueBool() {
+		return
+	}
+
+	// Only check when the workflow is activated by this plan
+	if !req.State.Raw.IsNull() {
+		var state workflowActivationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Active.ValueBool() {
+			return
+		}
+	}
+
+	workflow, err := r.client.GetWorkflow(plan.WorkflowID.ValueString())
+	if err != nil {
+		// Create reports a missing workflow
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Workflow",
+			"Could not read workflow ID "+plan.WorkflowID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(workflowTriggerNodes(workflow.Nodes)) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("active"),
+			"Workflow Cannot Be Activated",
+			"Workflow ID "+plan.WorkflowID.ValueString()+" has no enabled trigger, poller, or webhook node, so n8n cannot activate it. "+
+				"Manual, error, and execute workflow triggers only start a workflow on demand.",
+		)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *workflowActivationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowActivationResource{client: withContext(r.client, ctx)}
+
 	// Retrieve values from plan
 	var plan workflowActivationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -104,7 +183,7 @@ func (r *workflowActivationResource) Create(ctx context.Context, req resource.Cr
 	// Verify the workflow exists
 	workflow, err := r.client.GetWorkflow(plan.WorkflowID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Workflow Not Found",
 				"The workflow with ID "+plan.WorkflowID.ValueString()+" does not exist. Please ensure the workflow is created before managing its activation state.",
@@ -154,6 +233,9 @@ func (r *workflowActivationResource) Create(ctx context.Context, req resource.Cr
 
 // Read refreshes the Terraform state with the latest data.
 func (r *workflowActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowActivationResource{client: withContext(r.client, ctx)}
+
 	// Get current state
 	var state workflowActivationResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -162,11 +244,12 @@ func (r *workflowActivationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	// Get refreshed workflow value from n8n
-	workflow, err := r.client.GetWorkflow(state.WorkflowID.ValueString())
+	// Get refreshed workflow value from n8n, shared with the refresh of the
+	// workflow resource
+	workflow, err := r.client.RefreshWorkflowExcludingPinnedData(state.WorkflowID.ValueString())
 	if err != nil {
 		// Check if the workflow was deleted outside of Terraform (404 error)
-		if strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			// Remove from state - the workflow is gone
 			resp.State.RemoveResource(ctx)
 			return
@@ -179,8 +262,27 @@ func (r *workflowActivationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	// Update the active state
-	state.Active = types.BoolValue(workflow.Active)
+	// Not set after an import
+	if state.OnDrift.IsNull() {
+		state.OnDrift = types.StringValue(activationDriftReactivate)
+	}
+
+	// Update the active state unless the change outside of Terraform is ignored
+	switch {
+	case state.Active.IsNull() || state.Active.ValueBool() == workflow.Active:
+		state.Active = types.BoolValue(workflow.Active)
+	case state.OnDrift.ValueString() == activationDriftIgnore:
+	case state.OnDrift.ValueString() == activationDriftError:
+		resp.Diagnostics.AddError(
+			"n8n Workflow Activation Changed",
+			fmt.Sprintf("Workflow ID %s is expected to have active = %t, but it was changed to %t outside of Terraform. "+
+				"Restore the activation state in n8n, or set on_drift to 'reactivate' or 'ignore'.",
+				state.WorkflowID.ValueString(), state.Active.ValueBool(), workflow.Active),
+		)
+		return
+	default:
+		state.Active = types.BoolValue(workflow.Active)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -192,6 +294,9 @@ func (r *workflowActivationResource) Read(ctx context.Context, req resource.Read
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *workflowActivationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowActivationResource{client: withContext(r.client, ctx)}
+
 	// Retrieve values from plan
 	var plan workflowActivationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -243,6 +348,9 @@ func (r *workflowActivationResource) Update(ctx context.Context, req resource.Up
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *workflowActivationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowActivationResource{client: withContext(r.client, ctx)}
+
 	// Retrieve values from state
 	var state workflowActivationResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -256,7 +364,7 @@ func (r *workflowActivationResource) Delete(ctx context.Context, req resource.De
 	workflow, err := r.client.GetWorkflow(state.WorkflowID.ValueString())
 	if err != nil {
 		// If workflow doesn't exist, that's fine - nothing to deactivate
-		if strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -0,0 +1,47 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccWorkflowActivationResource(t *testing.T) {
+	config := func(active bool) string {
+		return testAccWorkflowConfig("tf-acc activation") + fmt.Sprintf(`
+resource "n8n_workflow_activation" "test" {
+  workflow_id = n8n_workflow.test.id
+  active      = %t
+}
+`, active)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_workflow_activation.test", "id"),
+					resource.TestCheckResourceAttrPair("n8n_workflow_activation.test", "workflow_id", "n8n_workflow.test", "id"),
+					resource.TestCheckResourceAttr("n8n_workflow_activation.test", "active", "true"),
+				),
+			},
+			{
+				Config:           config(false),
+				ConfigPlanChecks: testAccExpectAction("n8n_workflow_activation.test", plancheck.ResourceActionUpdate),
+				Check:            resource.TestCheckResourceAttr("n8n_workflow_activation.test", "active", "false"),
+			},
+			{
+				ResourceName:      "n8n_workflow_activation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
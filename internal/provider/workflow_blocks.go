@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Automatic layout used for node blocks without an explicit position: nodes
+// are placed left to right in declaration order.
+const (
+	workflowLayoutStartX  = 250
+	workflowLayoutStartY  = 300
+	workflowLayoutSpacing = 220
+)
+
+// workflowNodeBlockModel maps a node block of the workflow resource.
+type workflowNodeBlockModel struct {
+	Name        types.String  `tfsdk:"name"`
+	Type        types.String  `tfsdk:"type"`
+	TypeVersion types.Float64 `tfsdk:"type_version"`
+	Parameters  types.String  `tfsdk:"parameters"`
+	Credentials types.Map     `tfsdk:"credentials"`
+	Position    types.List    `tfsdk:"position"`
+}
+
+// workflowConnectionBlockModel maps a connect block of the workflow resource.
+type workflowConnectionBlockModel struct {
+	From        types.String `tfsdk:"from"`
+	To          types.String `tfsdk:"to"`
+	Type        types.String `tfsdk:"type"`
+	OutputIndex types.Int64  `tfsdk:"output_index"`
+	InputIndex  types.Int64  `tfsdk:"input_index"`
+}
+
+// workflowBlocksSchema returns the node, connect and timeouts blocks of the
+// workflow resource.
+func workflowBlocksSchema(ctx context.Context) map[string]schema.Block {
+	return map[string]schema.Block{
+		"node": schema.ListNestedBlock{
+			Description: "A workflow node defined in HCL. When node blocks are used, the nodes and connections JSON is generated from the node and connect blocks, and workflow_json, nodes, and connections must not be set.",
+			Validators: []validator.List{
+				listvalidator.AlsoRequires(path.MatchRoot("name")),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Unique name of the node within the workflow",
+						Required:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Node type (e.g., 'n8n-nodes-base.webhook')",
+						Required:    true,
+					},
+					"type_version": schema.Float64Attribute{
+						Description: "Version of the node type. Defaults to 1.",
+						Optional:    true,
+					},
+					"parameters": schema.StringAttribute{
+						Description: "JSON object with the node parameters, typically built with jsonencode()",
+						Optional:    true,
+					},
+					"credentials": schema.MapAttribute{
+						Description: "Credentials used by the node, keyed by credential type (e.g., 'slackApi') with the credential ID as value",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"position": schema.ListAttribute{
+						Description: "Canvas position of the node as [x, y]. Nodes without a position are laid out left to right in declaration order.",
+						Optional:    true,
+						ElementType: types.Int64Type,
+					},
+				},
+			},
+		},
+		// Not named connection: Terraform reserves that name for the
+		// connection block of provisioners, and connections is the JSON
+		// attribute
+		"connect": schema.ListNestedBlock{
+			Description: "A connection from an output of one node block to an input of another. " +
+				"The block is named connect because Terraform reserves the name connection.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"from": schema.StringAttribute{
+						Description: "Name of the source node",
+						Required:    true,
+					},
+					"to": schema.StringAttribute{
+						Description: "Name of the target node",
+						Required:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Connection type, e.g. 'ai_languageModel' or 'ai_tool' for AI sub-nodes. Defaults to 'main'.",
+						Optional:    true,
+					},
+					"output_index": schema.Int64Attribute{
+						Description: "Output of the source node to connect from (e.g., 1 for the false branch of an IF node). Defaults to 0.",
+						Optional:    true,
+					},
+					"input_index": schema.Int64Attribute{
+						Description: "Input of the target node to connect to (e.g., 1 for the second input of a Merge node). Defaults to 0.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"timeouts": timeoutsBlock(ctx),
+	}
+}
+
+// expandWorkflowBlocks renders the node and connect blocks of the plan into
+// the nodes and connections JSON attributes.
+func expandWorkflowBlocks(ctx context.Context, plan *workflowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.WorkflowJSON.IsNull() && plan.WorkflowJSON.ValueString() != "" {
+		diags.AddAttributeError(
+			path.Root("node"),
+			"Conflicting workflow definition",
+			"node blocks cannot be combined with workflow_json",
+		)
+		return diags
+	}
+
+	// nodes and connections are computed from the blocks, so a known value here
+	// can only come from the configuration.
+	for _, attr := range []struct {
+		name  string
+		value normalizedJSONValue
+	}{{"nodes", plan.Nodes}, {"connections", plan.Connections}} {
+		if !attr.value.IsNull() && !attr.value.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root(attr.name),
+				"Conflicting workflow definition",
+				"node blocks cannot be combined with the "+attr.name+" attribute",
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	nodes := make([]interface{}, 0, len(plan.Node))
+	names := make(map[string]bool, len(plan.Node))
+	for i, block := range plan.Node {
+		name := block.Name.ValueString()
+		if names[name] {
+			diags.AddAttributeError(
+				path.Root("node").AtListIndex(i).AtName("name"),
+				"Duplicate node name",
+				fmt.Sprintf("Node names must be unique within a workflow; %q is used more than once.", name),
+			)
+			continue
+		}
+		names[name] = true
+
+		typeVersion := 1.0
+		if !block.TypeVersion.IsNull() {
+			typeVersion = block.TypeVersion.ValueFloat64()
+		}
+
+		parameters := map[string]interface{}{}
+		if !block.Parameters.IsNull() && block.Parameters.ValueString() != "" {
+			if err := json.Unmarshal([]byte(block.Parameters.ValueString()), &parameters); err != nil {
+				diags.AddAttributeError(
+					path.Root("node").AtListIndex(i).AtName("parameters"),
+					"Error parsing node parameters",
+					"parameters must be a JSON object: "+err.Error(),
+				)
+				continue
+			}
+		}
+
+		position := []int64{
+			int64(workflowLayoutStartX + i*workflowLayoutSpacing),
+			workflowLayoutStartY,
+		}
+		if !block.Position.IsNull() {
+			var configured []int64
+			diags.Append(block.Position.ElementsAs(ctx, &configured, false)...)
+			if len(configured) != 2 {
+				diags.AddAttributeError(
+					path.Root("node").AtListIndex(i).AtName("position"),
+					"Invalid node position",
+					"position must contain exactly two numbers: [x, y]",
+				)
+				continue
+			}
+			position = configured
+		}
+
+		node := map[string]interface{}{
+			"name":        name,
+			"type":        block.Type.ValueString(),
+			"typeVersion": typeVersion,
+			"parameters":  parameters,
+			"position":    position,
+		}
+
+		if !block.Credentials.IsNull() {
+			var credentialIDs map[string]string
+			diags.Append(block.Credentials.ElementsAs(ctx, &credentialIDs, false)...)
+			credentials := make(map[string]interface{}, len(credentialIDs))
+			for credentialType, id := range credentialIDs {
+				credentials[credentialType] = map[string]interface{}{"id": id}
+			}
+			node["credentials"] = credentials
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	connections := make(map[string]interface{})
+	for i, block := range plan.Connect {
+		from, to := block.From.ValueString(), block.To.ValueString()
+		for _, ref := range []struct {
+			attr string
+			name string
+		}{{"from", from}, {"to", to}} {
+			if !names[ref.name] {
+				diags.AddAttributeError(
+					path.Root("connect").AtListIndex(i).AtName(ref.attr),
+					"Unknown node",
+					fmt.Sprintf("Connection references node %q, which is not defined by a node block.", ref.name),
+				)
+			}
+		}
+
+		connectionType := "main"
+		if !block.Type.IsNull() && block.Type.ValueString() != "" {
+			connectionType = block.Type.ValueString()
+		}
+
+		outputIndex := 0
+		if !block.OutputIndex.IsNull() {
+			outputIndex = int(block.OutputIndex.ValueInt64())
+		}
+		inputIndex := 0
+		if !block.InputIndex.IsNull() {
+			inputIndex = int(block.InputIndex.ValueInt64())
+		}
+		if outputIndex < 0 || inputIndex < 0 {
+			diags.AddAttributeError(
+				path.Root("connect").AtListIndex(i),
+				"Invalid connection index",
+				"output_index and input_index must not be negative",
+			)
+			continue
+		}
+
+		source, ok := connections[from].(map[string]interface{})
+		if !ok {
+			source = map[string]interface{}{}
+			connections[from] = source
+		}
+		outputs, _ := source[connectionType].([]interface{})
+		for len(outputs) <= outputIndex {
+			outputs = append(outputs, []interface{}{})
+		}
+		outputs[outputIndex] = append(outputs[outputIndex].([]interface{}), map[string]interface{}{
+			"node":  to,
+			"type":  connectionType,
+			"index": inputIndex,
+		})
+		source[connectionType] = outputs
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	nodesJSON, err := json.Marshal(nodes)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling nodes",
+			"Could not marshal nodes to JSON: "+err.Error(),
+		)
+		return diags
+	}
+	plan.Nodes = normalizedJSONString(string(nodesJSON))
+
+	connectionsJSON, err := json.Marshal(connections)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling connections",
+			"Could not marshal connections to JSON: "+err.Error(),
+		)
+		return diags
+	}
+	plan.Connections = normalizedJSONString(string(connectionsJSON))
+
+	return diags
+}
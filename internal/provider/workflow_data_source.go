@@ -25,7 +25,13 @@ func NewWorkflowDataSource() datasource.DataSource {
 
 // workflowDataSource is the data source implementation.
 type workflowDataSource struct {
-	client *client.Client
+	client workflowDataSourceClient
+}
+
+// workflowDataSourceClient is the part of the n8n API the workflow data source uses.
+type workflowDataSourceClient interface {
+	client.Endpoints
+	client.WorkflowsService
 }
 
 // workflowDataSourceModel maps the data source schema data.
@@ -39,6 +45,16 @@ type workflowDataSourceModel struct {
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
 	Active      types.Bool   `tfsdk:"active"`
+	WebhookURLs types.List   `tfsdk:"webhook_urls"`
+
+	OwnerProjectID types.String `tfsdk:"owner_project_id"`
+	OwnerEmail     types.String `tfsdk:"owner_email"`
+	SharedWith     types.Set    `tfsdk:"shared_with"`
+
+	NodeCount       types.Int64 `tfsdk:"node_count"`
+	TriggerTypes    types.Set   `tfsdk:"trigger_types"`
+	UsesCredentials types.Set   `tfsdk:"uses_credentials"`
+	HasWebhook      types.Bool  `tfsdk:"has_webhook"`
 }
 
 // Metadata returns the data source type name.
@@ -87,6 +103,38 @@ func (d *workflowDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Timestamp when the workflow was last updated",
 				Computed:    true,
 			},
+			"owner_project_id": schema.StringAttribute{
+				Description: ownerProjectIDDescription,
+				Computed:    true,
+			},
+			"owner_email": schema.StringAttribute{
+				Description: ownerEmailDescription,
+				Computed:    true,
+			},
+			"shared_with": schema.SetAttribute{
+				Description: sharedWithDescription,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"node_count": schema.Int64Attribute{
+				Description: nodeCountDescription,
+				Computed:    true,
+			},
+			"trigger_types": schema.SetAttribute{
+				Description: triggerTypesDescription,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"uses_credentials": schema.SetAttribute{
+				Description: usesCredentialsDescription,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"has_webhook": schema.BoolAttribute{
+				Description: hasWebhookDescription,
+				Computed:    true,
+			},
+			"webhook_urls": webhookURLsDataSourceSchemaAttribute(),
 		},
 	}
 }
@@ -97,12 +145,12 @@ func (d *workflowDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(workflowDataSourceClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -113,6 +161,9 @@ func (d *workflowDataSource) Configure(_ context.Context, req datasource.Configu
 
 // Read refreshes the Terraform state with the latest data.
 func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &workflowDataSource{client: withContext(d.client, ctx)}
+
 	var state workflowDataSourceModel
 
 	// Read configuration
@@ -122,8 +173,8 @@ func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Get workflow from n8n
-	workflow, err := d.client.GetWorkflow(state.ID.ValueString())
+	// Get workflow from n8n; pinned data is not exposed, so it is not read
+	workflow, err := d.client.GetWorkflowExcludingPinnedData(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading n8n Workflow",
@@ -137,6 +188,7 @@ func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 	state.Active = types.BoolValue(workflow.Active)
 	state.CreatedAt = types.StringValue(workflow.CreatedAt)
 	state.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+	state.OwnerProjectID, state.OwnerEmail, state.SharedWith = workflowOwnership(workflow)
 
 	// Convert nodes to JSON string
 	nodesJSON, err := json.Marshal(workflow.Nodes)
@@ -149,6 +201,13 @@ func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 	state.Nodes = types.StringValue(string(nodesJSON))
 
+	state.WebhookURLs, diags = workflowWebhookURLs(d.client.WebhookEndpoint(), workflow.Nodes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.NodeCount, state.TriggerTypes, state.UsesCredentials, state.HasWebhook = workflowNodeSummary(workflow.Nodes)
+
 	// Convert connections to JSON string
 	connectionsJSON, err := json.Marshal(workflow.Connections)
 	if err != nil {
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// executionPollInterval is how often a running execution is checked.
+const executionPollInterval = 2 * time.Second
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &workflowExecutionResource{}
+	_ resource.ResourceWithConfigure = &workflowExecutionResource{}
+)
+
+// NewWorkflowExecutionResource is a helper function to simplify the provider implementation.
+func NewWorkflowExecutionResource() resource.Resource {
+	return &workflowExecutionResource{}
+}
+
+// workflowExecutionResource is the resource implementation.
+type workflowExecutionResource struct {
+	client client.ExecutionsService
+}
+
+// workflowExecutionResourceModel maps the resource schema data.
+type workflowExecutionResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	WorkflowID        types.String `tfsdk:"workflow_id"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	TimeoutSeconds    types.Int64  `tfsdk:"timeout_seconds"`
+	FailOnError       types.Bool   `tfsdk:"fail_on_error"`
+	Status            types.String `tfsdk:"status"`
+	StartedAt         types.String `tfsdk:"started_at"`
+	StoppedAt         types.String `tfsdk:"stopped_at"`
+	Output            types.String `tfsdk:"output"`
+}
+
+// Metadata returns the resource type name.
+func (r *workflowExecutionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_execution"
+}
+
+// Schema defines the schema for the resource.
+func (r *workflowExecutionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an n8n workflow manually when created, for example to smoke-test a freshly deployed workflow. " +
+			"The workflow runs again whenever workflow_id or triggers change; destroying the resource does not affect the execution.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Execution identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				Description: "The ID of the workflow to run",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that cause a new execution when changed, such as the workflow's updated_at",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Wait until the execution finishes. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the execution to finish. Defaults to 300.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(300),
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Description: "Fail the apply if the execution does not succeed. Only applies when waiting for completion. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the execution, e.g. 'success', 'error' or 'running'",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				Description: "Timestamp when the execution started",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stopped_at": schema.StringAttribute{
+				Description: "Timestamp when the execution finished, or empty if it was still running",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output": schema.StringAttribute{
+				Description: "JSON array of the items returned by the last node of the execution",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workflowExecutionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ExecutionsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create runs the workflow and sets the initial Terraform state.
+func (r *workflowExecutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowExecutionResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan workflowExecutionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	executionID, err := r.client.RunWorkflow(plan.WorkflowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Running n8n Workflow",
+			"Could not run workflow ID "+plan.WorkflowID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	execution, err := r.client.GetExecution(executionID, plan.WaitForCompletion.ValueBool())
+	if err == nil && plan.WaitForCompletion.ValueBool() {
+		execution, err = r.waitForExecution(ctx, executionID, time.Duration(plan.TimeoutSeconds.ValueInt64())*time.Second)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Execution",
+			"Could not read execution ID "+executionID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(execution.ID)
+	plan.Status = types.StringValue(execution.Status)
+	plan.StartedAt = types.StringValue(execution.StartedAt)
+	plan.StoppedAt = types.StringValue(execution.StoppedAt)
+
+	output := execution.LastNodeOutput()
+	if output == nil {
+		output = []map[string]interface{}{}
+	}
+	outputJSON, err := json.Marshal(output)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshaling output",
+			"Could not marshal execution output to JSON: "+err.Error(),
+		)
+		return
+	}
+	plan.Output = types.StringValue(string(outputJSON))
+
+	if plan.WaitForCompletion.ValueBool() && plan.FailOnError.ValueBool() && execution.Status != "success" {
+		resp.Diagnostics.AddError(
+			"n8n Workflow Execution Failed",
+			fmt.Sprintf("Execution %s of workflow %s finished with status %q.", execution.ID, plan.WorkflowID.ValueString(), execution.Status),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// waitForExecution polls an execution until it stops running or the timeout expires.
+func (r *workflowExecutionResource) waitForExecution(ctx context.Context, id string, timeout time.Duration) (*client.Execution, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		execution, err := r.client.GetExecution(id, true)
+		if err != nil {
+			return nil, err
+		}
+		if !execution.IsRunning() {
+			return execution, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("execution still %s after %s", execution.Status, timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(executionPollInterval):
+		}
+	}
+}
+
+// Read keeps the recorded execution; it is a one-time event.
+func (r *workflowExecutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workflowExecutionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only records changes to the waiting options; they apply to the next execution.
+func (r *workflowExecutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workflowExecutionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the Terraform state only.
+func (r *workflowExecutionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Executions are history; there is nothing to undo
+}
@@ -0,0 +1,42 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkflowExecutionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "n8n_workflow" "test" {
+  name = "tf-acc execution"
+
+  nodes = jsonencode([
+    {
+      id          = "1"
+      name        = "Manual Trigger"
+      type        = "n8n-nodes-base.manualTrigger"
+      typeVersion = 1
+      position    = [0, 0]
+      parameters  = {}
+    }
+  ])
+  connections = jsonencode({})
+}
+
+resource "n8n_workflow_execution" "test" {
+  workflow_id = n8n_workflow.test.id
+}
+`,
+				Check: resource.TestCheckResourceAttr("n8n_workflow_execution.test", "status", "success"),
+			},
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// templateVarPattern matches the ${var:NAME} placeholders of workflow_json.
+var templateVarPattern = regexp.MustCompile(`\$\{var:([A-Za-z0-9_.-]+)\}`)
+
+// renderTemplateVars replaces the ${var:NAME} placeholders in the workflow
+// JSON with the values of vars. Placeholders are expected inside JSON
+// strings, so the values are escaped for use within one. Placeholders without
+// a value are an error.
+func renderTemplateVars(workflowJSON string, vars map[string]string) (string, error) {
+	missing := map[string]bool{}
+	rendered := templateVarPattern.ReplaceAllStringFunc(workflowJSON, func(placeholder string) string {
+		name := templateVarPattern.FindStringSubmatch(placeholder)[1]
+		value, ok := vars[name]
+		if !ok {
+			missing[name] = true
+			return placeholder
+		}
+
+		escaped, _ := json.Marshal(value)
+		return string(escaped[1 : len(escaped)-1])
+	})
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("workflow_json uses template variables that are not set in template_vars: %s", strings.Join(names, ", "))
+	}
+
+	return rendered, nil
+}
+
+// mapWorkflowCredentials rewrites the credential references of the nodes in
+// place. A reference is rewritten when its credential ID or name is a key of
+// mappings; it then points at the credential ID the key maps to.
+func mapWorkflowCredentials(nodes []interface{}, mappings map[string]string) {
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		credentials, ok := node["credentials"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, c := range credentials {
+			ref, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, _ := ref["id"].(string)
+			name, _ := ref["name"].(string)
+
+			if target, ok := mappings[id]; ok && id != "" {
+				ref["id"] = target
+			} else if target, ok := mappings[name]; ok && name != "" {
+				ref["id"] = target
+			}
+		}
+	}
+}
+
+// plannedWorkflowJSONNodes returns the nodes of workflow_json the way Create
+// and Update submit them: with the template variables substituted and the
+// credential references mapped. The nodes are nil when workflow_json is not
+// set, is invalid, or depends on values that are not known yet.
+func plannedWorkflowJSONNodes(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) []interface{} {
+	var workflowJSON normalizedJSONValue
+	var templateVars, credentialMappings types.Map
+	diags.Append(config.GetAttribute(ctx, path.Root("workflow_json"), &workflowJSON)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("template_vars"), &templateVars)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("credential_mappings"), &credentialMappings)...)
+	if diags.HasError() || workflowJSON.IsNull() || workflowJSON.IsUnknown() || workflowJSON.ValueString() == "" {
+		return nil
+	}
+
+	vars, known := knownStringMap(ctx, templateVars, diags)
+	if !known {
+		return nil
+	}
+	mappings, known := knownStringMap(ctx, credentialMappings, diags)
+	if !known {
+		return nil
+	}
+
+	// Create and Update report the problems of the document
+	rendered, err := renderTemplateVars(workflowJSON.ValueString(), vars)
+	if err != nil {
+		return nil
+	}
+	doc, problems := parseWorkflowJSON(rendered)
+	if len(problems) > 0 {
+		return nil
+	}
+
+	mapWorkflowCredentials(doc.nodes, mappings)
+	return doc.nodes
+}
+
+// knownStringMap returns the elements of a map of strings, and whether they
+// are all known. A null map has no elements.
+func knownStringMap(ctx context.Context, value types.Map, diags *diag.Diagnostics) (map[string]string, bool) {
+	if value.IsUnknown() {
+		return nil, false
+	}
+	if value.IsNull() {
+		return nil, true
+	}
+	for _, element := range value.Elements() {
+		if element.IsUnknown() {
+			return nil, false
+		}
+	}
+
+	var elements map[string]string
+	diags.Append(value.ElementsAs(ctx, &elements, false)...)
+	return elements, !diags.HasError()
+}
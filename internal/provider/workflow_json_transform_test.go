@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRenderTemplateVars(t *testing.T) {
+	tests := map[string]struct {
+		workflowJSON string
+		vars         map[string]string
+		want         string
+		wantErr      string
+	}{
+		"no placeholders": {
+			workflowJSON: `{"name":"Alerts"}`,
+			want:         `{"name":"Alerts"}`,
+		},
+		"placeholders": {
+			workflowJSON: `{"name":"Alerts ${var:ENV}","channel":"${var:SLACK_CHANNEL}"}`,
+			vars:         map[string]string{"ENV": "prod", "SLACK_CHANNEL": "#alerts"},
+			want:         `{"name":"Alerts prod","channel":"#alerts"}`,
+		},
+		"repeated placeholder": {
+			workflowJSON: `{"a":"${var:ENV}","b":"${var:ENV}"}`,
+			vars:         map[string]string{"ENV": "dev"},
+			want:         `{"a":"dev","b":"dev"}`,
+		},
+		"values are escaped": {
+			workflowJSON: `{"text":"${var:MESSAGE}"}`,
+			vars:         map[string]string{"MESSAGE": "say \"hi\"\nbye"},
+			want:         `{"text":"say \"hi\"\nbye"}`,
+		},
+		"unused vars": {
+			workflowJSON: `{"name":"Alerts"}`,
+			vars:         map[string]string{"ENV": "prod"},
+			want:         `{"name":"Alerts"}`,
+		},
+		"n8n expressions are left alone": {
+			workflowJSON: `{"text":"={{ $json.message }} ${var}"}`,
+			want:         `{"text":"={{ $json.message }} ${var}"}`,
+		},
+		"missing vars": {
+			workflowJSON: `{"a":"${var:B_VAR}","b":"${var:A_VAR}","c":"${var:B_VAR}"}`,
+			wantErr:      "workflow_json uses template variables that are not set in template_vars: A_VAR, B_VAR",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := renderTemplateVars(tt.workflowJSON, tt.vars)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("renderTemplateVars() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("renderTemplateVars() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("renderTemplateVars() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapWorkflowCredentials(t *testing.T) {
+	tests := map[string]struct {
+		nodes    string
+		mappings map[string]string
+		want     string
+	}{
+		"by id": {
+			nodes:    `[{"name":"Slack","credentials":{"slackApi":{"id":"old","name":"Slack account"}}}]`,
+			mappings: map[string]string{"old": "new"},
+			want:     `[{"name":"Slack","credentials":{"slackApi":{"id":"new","name":"Slack account"}}}]`,
+		},
+		"by name": {
+			nodes:    `[{"name":"Slack","credentials":{"slackApi":{"id":"old","name":"Slack account"}}}]`,
+			mappings: map[string]string{"Slack account": "new"},
+			want:     `[{"name":"Slack","credentials":{"slackApi":{"id":"new","name":"Slack account"}}}]`,
+		},
+		"id wins over name": {
+			nodes:    `[{"name":"Slack","credentials":{"slackApi":{"id":"old","name":"Slack account"}}}]`,
+			mappings: map[string]string{"old": "by-id", "Slack account": "by-name"},
+			want:     `[{"name":"Slack","credentials":{"slackApi":{"id":"by-id","name":"Slack account"}}}]`,
+		},
+		"reference without id": {
+			nodes:    `[{"name":"Slack","credentials":{"slackApi":{"name":"Slack account"}}}]`,
+			mappings: map[string]string{"Slack account": "new"},
+			want:     `[{"name":"Slack","credentials":{"slackApi":{"id":"new","name":"Slack account"}}}]`,
+		},
+		"several credentials": {
+			nodes:    `[{"name":"HTTP","credentials":{"httpBasicAuth":{"id":"a"},"httpHeaderAuth":{"id":"b"}}}]`,
+			mappings: map[string]string{"a": "x"},
+			want:     `[{"name":"HTTP","credentials":{"httpBasicAuth":{"id":"x"},"httpHeaderAuth":{"id":"b"}}}]`,
+		},
+		"unmapped": {
+			nodes:    `[{"name":"Slack","credentials":{"slackApi":{"id":"old","name":"Slack account"}}}]`,
+			mappings: map[string]string{"other": "new"},
+			want:     `[{"name":"Slack","credentials":{"slackApi":{"id":"old","name":"Slack account"}}}]`,
+		},
+		"empty id and name are not mapped": {
+			nodes:    `[{"name":"Slack","credentials":{"slackApi":{"id":"","name":""}}}]`,
+			mappings: map[string]string{"": "new"},
+			want:     `[{"name":"Slack","credentials":{"slackApi":{"id":"","name":""}}}]`,
+		},
+		"malformed nodes are skipped": {
+			nodes:    `["Slack",{"name":"Set"},{"name":"HTTP","credentials":"none"},{"name":"Mail","credentials":{"smtp":"none"}}]`,
+			mappings: map[string]string{"none": "new"},
+			want:     `["Slack",{"name":"Set"},{"name":"HTTP","credentials":"none"},{"name":"Mail","credentials":{"smtp":"none"}}]`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			nodes := testDecodeNodes(t, tt.nodes)
+			mapWorkflowCredentials(nodes, tt.mappings)
+			if !reflect.DeepEqual(nodes, testDecodeNodes(t, tt.want)) {
+				got, _ := json.Marshal(nodes)
+				t.Errorf("mapWorkflowCredentials() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlannedWorkflowJSONNodes(t *testing.T) {
+	str := func(value string) tftypes.Value { return tftypes.NewValue(tftypes.String, value) }
+	strMap := func(values map[string]tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values)
+	}
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	const workflowJSON = `{"name":"Alerts","connections":{},"nodes":[{"name":"Slack","credentials":{"slackApi":{"id":"${var:CREDENTIAL}","name":"Slack account"}}}]}`
+
+	tests := map[string]struct {
+		values map[string]tftypes.Value
+		want   string
+	}{
+		"mapped": {
+			values: map[string]tftypes.Value{
+				"workflow_json":       str(workflowJSON),
+				"template_vars":       strMap(map[string]tftypes.Value{"CREDENTIAL": str("old")}),
+				"credential_mappings": strMap(map[string]tftypes.Value{"old": str("new")}),
+			},
+			want: `[{"name":"Slack","credentials":{"slackApi":{"id":"new","name":"Slack account"}}}]`,
+		},
+		"no mappings": {
+			values: map[string]tftypes.Value{
+				"workflow_json": str(workflowJSON),
+				"template_vars": strMap(map[string]tftypes.Value{"CREDENTIAL": str("old")}),
+			},
+			want: `[{"name":"Slack","credentials":{"slackApi":{"id":"old","name":"Slack account"}}}]`,
+		},
+		"mapping not known yet": {
+			values: map[string]tftypes.Value{
+				"workflow_json":       str(workflowJSON),
+				"template_vars":       strMap(map[string]tftypes.Value{"CREDENTIAL": str("old")}),
+				"credential_mappings": strMap(map[string]tftypes.Value{"old": unknown}),
+			},
+		},
+		"template variable not known yet": {
+			values: map[string]tftypes.Value{
+				"workflow_json": str(workflowJSON),
+				"template_vars": strMap(map[string]tftypes.Value{"CREDENTIAL": unknown}),
+			},
+		},
+		"template variable missing": {
+			values: map[string]tftypes.Value{"workflow_json": str(workflowJSON)},
+		},
+		"workflow_json not known yet": {
+			values: map[string]tftypes.Value{"workflow_json": unknown},
+		},
+		"no workflow_json": {
+			values: map[string]tftypes.Value{"name": str("Alerts"), "nodes": str("[]"), "connections": str("{}")},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			var schemaResp resource.SchemaResponse
+			(&workflowResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			raw, err := workflowConfig(t, tt.values).Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				t.Fatalf("config: %v", err)
+			}
+
+			var diags diag.Diagnostics
+			got := plannedWorkflowJSONNodes(ctx, tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}, &diags)
+			if diags.HasError() {
+				t.Fatalf("plannedWorkflowJSONNodes() diagnostics = %v", diags)
+			}
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("plannedWorkflowJSONNodes() = %v, want nil", got)
+				}
+				return
+			}
+			if want := testDecodeNodes(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("plannedWorkflowJSONNodes() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"encoding/json"
+)
+
+// defaultIgnoredServerFields are the node fields n8n fills in on its own.
+var defaultIgnoredServerFields = []string{"id", "webhookId"}
+
+// nodesByName indexes workflow nodes by their name, which is unique within a
+// workflow.
+func nodesByName(nodes []interface{}) map[string]map[string]interface{} {
+	byName := make(map[string]map[string]interface{}, len(nodes))
+	for _, n := range nodes {
+		if node, ok := n.(map[string]interface{}); ok {
+			if name, ok := node["name"].(string); ok {
+				byName[name] = node
+			}
+		}
+	}
+	return byName
+}
+
+// decodeNodes decodes a nodes JSON string, returning nil if it is not valid.
+func decodeNodes(nodesJSON string) []interface{} {
+	var nodes []interface{}
+	if err := json.Unmarshal([]byte(nodesJSON), &nodes); err != nil {
+		return nil
+	}
+	return nodes
+}
+
+// stripServerFields removes the given fields from the nodes returned by n8n,
+// unless the node of the same name in the prior nodes JSON sets the field
+// itself. This keeps fields n8n adds on its own out of state, while values
+// the configuration manages are still refreshed. The nodes are copied, so
+// the result can be modified without changing nodes.
+func stripServerFields(nodes []interface{}, priorNodesJSON string, fields []string) []interface{} {
+	priorByName := nodesByName(decodeNodes(priorNodesJSON))
+
+	stripped := make([]interface{}, 0, len(nodes))
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			stripped = append(stripped, n)
+			continue
+		}
+
+		name, _ := node["name"].(string)
+		prior := priorByName[name]
+
+		copied := make(map[string]interface{}, len(node))
+		for key, value := range node {
+			copied[key] = value
+		}
+		for _, field := range fields {
+			if _, managed := prior[field]; !managed {
+				delete(copied, field)
+			}
+		}
+		stripped = append(stripped, copied)
+	}
+
+	return stripped
+}
+
+// copyNodeField sets field on every node in nodes to the value of the node of
+// the same name in from, or removes it if that node does not have it. Nodes
+// without a counterpart are left alone. nodes is modified in place.
+func copyNodeField(nodes []interface{}, from []interface{}, field string) {
+	fromByName := nodesByName(from)
+
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := node["name"].(string)
+		source, ok := fromByName[name]
+		if !ok {
+			continue
+		}
+
+		if value, ok := source[field]; ok {
+			node[field] = value
+		} else {
+			delete(node, field)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestStripServerFields(t *testing.T) {
+	tests := map[string]struct {
+		nodes  string
+		prior  string
+		fields []string
+		want   string
+	}{
+		"server fields removed": {
+			nodes:  `[{"id":"abc","name":"Webhook","webhookId":"def","type":"n8n-nodes-base.webhook"}]`,
+			prior:  `[{"name":"Webhook","type":"n8n-nodes-base.webhook"}]`,
+			fields: defaultIgnoredServerFields,
+			want:   `[{"name":"Webhook","type":"n8n-nodes-base.webhook"}]`,
+		},
+		"managed fields kept": {
+			nodes:  `[{"id":"abc","name":"Webhook","webhookId":"def"}]`,
+			prior:  `[{"id":"1","name":"Webhook"}]`,
+			fields: defaultIgnoredServerFields,
+			want:   `[{"id":"abc","name":"Webhook"}]`,
+		},
+		"new node": {
+			nodes:  `[{"id":"abc","name":"Webhook"},{"id":"ghi","name":"Slack"}]`,
+			prior:  `[{"id":"1","name":"Webhook"}]`,
+			fields: defaultIgnoredServerFields,
+			want:   `[{"id":"abc","name":"Webhook"},{"name":"Slack"}]`,
+		},
+		"no prior nodes": {
+			nodes:  `[{"id":"abc","name":"Webhook","webhookId":"def"}]`,
+			fields: defaultIgnoredServerFields,
+			want:   `[{"name":"Webhook"}]`,
+		},
+		"custom fields": {
+			nodes:  `[{"id":"abc","name":"Webhook","notesInFlow":true}]`,
+			prior:  `[{"name":"Webhook"}]`,
+			fields: []string{"notesInFlow"},
+			want:   `[{"id":"abc","name":"Webhook"}]`,
+		},
+		"no fields": {
+			nodes: `[{"id":"abc","name":"Webhook"}]`,
+			want:  `[{"id":"abc","name":"Webhook"}]`,
+		},
+		"malformed nodes kept": {
+			nodes:  `["Webhook",{"id":"abc"}]`,
+			fields: defaultIgnoredServerFields,
+			want:   `["Webhook",{}]`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			nodes := testDecodeNodes(t, tt.nodes)
+			original := testDecodeNodes(t, tt.nodes)
+			want := testDecodeNodes(t, tt.want)
+
+			got := stripServerFields(nodes, tt.prior, tt.fields)
+			if !reflect.DeepEqual(got, want) {
+				gotJSON, _ := json.Marshal(got)
+				t.Errorf("stripServerFields() = %s, want %s", gotJSON, tt.want)
+			}
+			if !reflect.DeepEqual(nodes, original) {
+				t.Error("stripServerFields() changed the nodes it was given")
+			}
+		})
+	}
+}
+
+// testDecodeNodes decodes a JSON array of nodes, failing the test if it is not
+func testDecodeNodes(t *testing.T, nodesJSON string) []interface{} {
+	t.Helper()
+	var nodes []interface{}
+	if err := json.Unmarshal([]byte(nodesJSON), &nodes); err != nil {
+		t.Fatalf("unmarshalling %s: %v", nodesJSON, err)
+	}
+	return nodes
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// personalProjectEmailPattern matches the email in the name n8n gives the
+// personal project of a user, e.g. "Jane Doe <jane@example.com>".
+var personalProjectEmailPattern = regexp.MustCompile(`<([^<>]+@[^<>]+)>\s*$`)
+
+// Descriptions of the ownership attributes of the workflow resource and data source.
+const (
+	ownerProjectIDDescription = "ID of the project that owns the workflow"
+	ownerEmailDescription     = "Email of the user that owns the workflow, when it is in a personal project"
+	sharedWithDescription     = "IDs of the projects the workflow is shared with, not including its owner"
+)
+
+// workflowOwnership returns the owner project ID, owner email and the
+// projects the workflow is shared with. Values n8n did not report are null.
+func workflowOwnership(workflow *client.Workflow) (types.String, types.String, types.Set) {
+	ownerProjectID := types.StringNull()
+	ownerEmail := types.StringNull()
+	sharedWith := []attr.Value{}
+
+	for _, shared := range workflow.Shared {
+		if shared.Role != "workflow:owner" {
+			sharedWith = append(sharedWith, types.StringValue(shared.ProjectID))
+			continue
+		}
+
+		ownerProjectID = types.StringValue(shared.ProjectID)
+		if shared.Project != nil && shared.Project.Type == "personal" {
+			if match := personalProjectEmailPattern.FindStringSubmatch(shared.Project.Name); match != nil {
+				ownerEmail = types.StringValue(match[1])
+			}
+		}
+	}
+
+	return ownerProjectID, ownerEmail, types.SetValueMust(types.StringType, sharedWith)
+}
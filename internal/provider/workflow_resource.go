@@ -4,13 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
+	"maps"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/pinotelio/terraform-provider-n8n/internal/client"
@@ -18,9 +27,19 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &workflowResource{}
-	_ resource.ResourceWithConfigure   = &workflowResource{}
-	_ resource.ResourceWithImportState = &workflowResource{}
+	_ resource.Resource                     = &workflowResource{}
+	_ resource.ResourceWithConfigure        = &workflowResource{}
+	_ resource.ResourceWithImportState      = &workflowResource{}
+	_ resource.ResourceWithIdentity         = &workflowResource{}
+	_ resource.ResourceWithValidateConfig   = &workflowResource{}
+	_ resource.ResourceWithConfigValidators = &workflowResource{}
+	_ resource.ResourceWithModifyPlan       = &workflowResource{}
+)
+
+// What destroying an n8n_workflow does with the workflow.
+const (
+	workflowDestroyDelete  = "delete"
+	workflowDestroyArchive = "archive"
 )
 
 // NewWorkflowResource is a helper function to simplify the provider implementation.
@@ -30,20 +49,65 @@ func NewWorkflowResource() resource.Resource {
 
 // workflowResource is the resource implementation.
 type workflowResource struct {
-	client *client.Client
+	client workflowResourceClient
+}
+
+// workflowResourceClient is the part of the n8n API the workflow resource uses.
+type workflowResourceClient interface {
+	client.CredentialsService
+	client.Endpoints
+	client.InstanceService
+	client.TagsService
+	client.WorkflowsService
 }
 
 // workflowResourceModel maps the resource schema data.
 type workflowResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	WorkflowJSON types.String `tfsdk:"workflow_json"`
-	Nodes        types.String `tfsdk:"nodes"`
-	Connections  types.String `tfsdk:"connections"`
-	Settings     types.String `tfsdk:"settings"`
-	Tags         types.String `tfsdk:"tags"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	UpdatedAt    types.String `tfsdk:"updated_at"`
+	ID                       types.String        `tfsdk:"id"`
+	Name                     types.String        `tfsdk:"name"`
+	WorkflowJSON             normalizedJSONValue `tfsdk:"workflow_json"`
+	Nodes                    normalizedJSONValue `tfsdk:"nodes"`
+	Connections              normalizedJSONValue `tfsdk:"connections"`
+	Settings                 normalizedJSONValue `tfsdk:"settings"`
+	PinData                  normalizedJSONValue `tfsdk:"pin_data"`
+	ExcludePinnedData        types.Bool          `tfsdk:"exclude_pinned_data"`
+	IncludeStaticData        types.Bool          `tfsdk:"include_static_data"`
+	StaticData               types.String        `tfsdk:"static_data"`
+	OverwriteRemoteChanges   types.Bool          `tfsdk:"overwrite_remote_changes"`
+	AppliedVersion           types.String        `tfsdk:"applied_version"`
+	PreventDestroyWhenActive types.Bool          `tfsdk:"prevent_destroy_when_active"`
+	DeactivateBeforeDelete   types.Bool          `tfsdk:"deactivate_before_delete"`
+	OnDestroy                types.String        `tfsdk:"on_destroy"`
+	NodeCount                types.Int64         `tfsdk:"node_count"`
+	TriggerTypes             types.Set           `tfsdk:"trigger_types"`
+	UsesCredentials          types.Set           `tfsdk:"uses_credentials"`
+	HasWebhook               types.Bool          `tfsdk:"has_webhook"`
+	ValidateCredentials      types.Bool          `tfsdk:"validate_credentials"`
+	CredentialAllowlist      types.Set           `tfsdk:"credential_allowlist"`
+	ValidateNodeTypes        types.Bool          `tfsdk:"validate_node_types"`
+	Tags                     types.String        `tfsdk:"tags"`
+	TagIDs                   types.Set           `tfsdk:"tag_ids"`
+	TagNames                 types.Set           `tfsdk:"tag_names"`
+	CreateMissingTags        types.Bool          `tfsdk:"create_missing_tags"`
+	OwnerProjectID           types.String        `tfsdk:"owner_project_id"`
+	OwnerEmail               types.String        `tfsdk:"owner_email"`
+	SharedWith               types.Set           `tfsdk:"shared_with"`
+	CreatedAt                types.String        `tfsdk:"created_at"`
+	UpdatedAt                types.String        `tfsdk:"updated_at"`
+	AdoptExisting            types.Bool          `tfsdk:"adopt_existing"`
+	ProjectID                types.String        `tfsdk:"project_id"`
+	FolderID                 types.String        `tfsdk:"folder_id"`
+	WebhookURLs              types.List          `tfsdk:"webhook_urls"`
+	IgnoreServerFields       types.Set           `tfsdk:"ignore_server_fields"`
+	IgnoreNodePositions      types.Bool          `tfsdk:"ignore_node_positions"`
+	CredentialMappings       types.Map           `tfsdk:"credential_mappings"`
+	TemplateVars             types.Map           `tfsdk:"template_vars"`
+	Active                   types.Bool          `tfsdk:"active"`
+	ReactivateOnUpdate       types.Bool          `tfsdk:"reactivate_on_update"`
+
+	Node     []workflowNodeBlockModel       `tfsdk:"node"`
+	Connect  []workflowConnectionBlockModel `tfsdk:"connect"`
+	Timeouts timeouts.Value                 `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -52,9 +116,9 @@ func (r *workflowResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *workflowResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages an n8n workflow. You can either specify individual attributes (name, nodes, connections, etc.) or provide a complete workflow JSON using the workflow_json attribute.",
+		Description: "Manages an n8n workflow. You can either specify individual attributes (name, nodes, connections, etc.), provide a complete workflow JSON using the workflow_json attribute, or define the workflow in HCL with node and connect blocks.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Workflow identifier",
@@ -64,33 +128,164 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the workflow. Optional if workflow_json is provided.",
+				Description: "Name of the workflow. Optional if workflow_json is provided; otherwise it must be set together with nodes and connections, or node blocks.",
 				Optional:    true,
 				Computed:    true,
 			},
 			"nodes": schema.StringAttribute{
-				Description: "JSON string representing the workflow nodes. Optional if workflow_json is provided.",
+				CustomType:  normalizedJSONType{},
+				Description: "JSON string representing the workflow nodes. Must be set together with name and connections, and not together with workflow_json.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
 			},
 			"connections": schema.StringAttribute{
-				Description: "JSON string representing the workflow connections. Optional if workflow_json is provided.",
+				CustomType:  normalizedJSONType{},
+				Description: "JSON string representing the workflow connections. Must be set together with name and nodes, and not together with workflow_json.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
 			},
 			"settings": schema.StringAttribute{
+				CustomType:  normalizedJSONType{},
 				Description: "JSON string representing the workflow settings",
 				Optional:    true,
 				Computed:    true,
 			},
+			"pin_data": schema.StringAttribute{
+				CustomType: normalizedJSONType{},
+				Description: "JSON object with the output pinned to nodes in the editor, keyed by node name. " +
+					"If not set, pinned data is neither stored in state nor changed; set it to \"{}\" to remove all pinned data.",
+				Optional: true,
+			},
+			"exclude_pinned_data": schema.BoolAttribute{
+				Description: "When true, the workflow is read without its pinned data, which keeps large test fixtures from being downloaded on every refresh. " +
+					"Cannot be combined with pin_data. Defaults to false, in which case the exclude_pinned_data setting of the provider applies.",
+				Optional: true,
+			},
+			"include_static_data": schema.BoolAttribute{
+				Description: "When true, the static data trigger nodes keep between executions is exposed in static_data. Defaults to false.",
+				Optional:    true,
+			},
+			"static_data": schema.StringAttribute{
+				Description: "JSON object with the static data of the workflow, such as the last poll time of polling triggers. " +
+					"Only set when include_static_data is true. Static data changes as the workflow runs, so it is never compared with the configuration or sent to n8n.",
+				Computed: true,
+			},
 			"tags": schema.StringAttribute{
-				Description: "JSON string representing the workflow tags",
+				Description:        "JSON string representing the workflow tags",
+				Optional:           true,
+				Computed:           true,
+				DeprecationMessage: "Use tag_ids or tag_names instead. tags remains available as a computed attribute.",
+			},
+			"tag_ids": schema.SetAttribute{
+				Description: "IDs of the tags of the workflow. If neither tag_ids nor tag_names is set, the tags are left alone.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tag_names": schema.SetAttribute{
+				Description: "Names of the tags of the workflow, resolved to tag IDs when the workflow is created or updated. " +
+					"The tags must exist unless create_missing_tags is set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"create_missing_tags": schema.BoolAttribute{
+				Description: "When true, tags in tag_names that do not exist yet are created. Defaults to false.",
 				Optional:    true,
-				Computed:    true,
 			},
 			"workflow_json": schema.StringAttribute{
-				Description: "Complete workflow JSON. When provided, individual attributes (name, nodes, connections, etc.) are extracted from this JSON. This allows you to paste an entire n8n workflow export directly.",
+				CustomType: normalizedJSONType{},
+				Description: "Complete workflow JSON. When provided, individual attributes (name, nodes, connections, etc.) are extracted from this JSON. This allows you to paste an entire n8n workflow export directly. " +
+					"After an import, or when the workflow is changed outside of Terraform, it is set to a JSON document with the name, nodes, connections, and settings of the workflow.",
+				Optional: true,
+				Computed: true,
+			},
+			"template_vars": schema.MapAttribute{
+				Description: "Values substituted for ${var:NAME} placeholders in workflow_json before it is submitted, so one export can serve several environments. " +
+					"Placeholders must be inside JSON strings; using a placeholder without a value is an error.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"credential_mappings": schema.MapAttribute{
+				Description: "Rewrites the credential references of the nodes in workflow_json. Keys are credential IDs or names used in the export " +
+					"(or placeholders used in their place), values are the IDs of the credentials to use instead, typically n8n_credential resources. " +
+					"The mappings are applied at plan time, so nodes shows the mapped IDs and credential_allowlist is checked against them, " +
+					"unless they refer to credentials that are only created by the apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: "When true, the credentials the nodes reference are checked to exist before the workflow is created or updated, " +
+					"so a missing credential fails the apply instead of later executions. Defaults to false.",
+				Optional: true,
+			},
+			"credential_allowlist": schema.SetAttribute{
+				Description: "IDs of the credentials the nodes may reference when validate_credentials is true. " +
+					"If not set, the credentials are looked up on the instance, which requires an API key that can list them.",
 				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"validate_node_types": schema.BoolAttribute{
+				Description: "When true, terraform plan fails if the workflow uses node types, or node type versions, that are not installed on the instance, " +
+					"such as community nodes that have not been installed yet. Defaults to false.",
+				Optional: true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "When true and a workflow with the same name already exists at create time, adopt it into Terraform state and update it to match the configuration instead of creating a duplicate. Creation fails if more than one workflow has that name.",
+				Optional:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "ID of the project that owns the workflow. The workflow is created in this project, and changing it transfers the workflow to the new project. " +
+					"If not set, the workflow is created in the personal project of the API key owner and stays in whatever project it is in.",
+				Optional: true,
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "ID of the n8n_folder the workflow is in. The folder must belong to the workflow's project. " +
+					"If not set, the workflow stays in the folder it is currently in.",
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the workflow is active. The workflow is activated or deactivated after it is created or updated. " +
+					"If not set, the activation state is left alone, for example to manage it with n8n_workflow_activation instead. " +
+					"Workflows must have at least one trigger, poller, or webhook node to be activated.",
+				Optional: true,
+			},
+			"reactivate_on_update": schema.BoolAttribute{
+				Description: "When true, an active workflow is deactivated and activated again after each update, so that n8n registers its triggers and webhooks anew. Defaults to false.",
+				Optional:    true,
+			},
+			"overwrite_remote_changes": schema.BoolAttribute{
+				Description: "When false, an update is aborted if the workflow was edited outside of Terraform since it was last applied, " +
+					"so that changes made in the n8n editor are not silently overwritten. Defaults to true.",
+				Optional: true,
+			},
+			"prevent_destroy_when_active": schema.BoolAttribute{
+				Description: "When true, destroying the workflow fails while it is active, so a workflow serving production traffic is not removed by accident. " +
+					"Like all destroy options, it must be applied before the destroy to take effect. Defaults to false.",
+				Optional: true,
+			},
+			"deactivate_before_delete": schema.BoolAttribute{
+				Description: "When true, an active workflow is deactivated before it is deleted, so n8n unregisters its triggers and webhooks cleanly. Defaults to false.",
+				Optional:    true,
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What destroying the workflow does: 'delete' removes it permanently, also on n8n versions that archive deleted workflows, " +
+					"and 'archive' archives it so it can be restored in the editor. Defaults to 'delete'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(workflowDestroyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(workflowDestroyDelete, workflowDestroyArchive),
+				},
+			},
+			"applied_version": schema.StringAttribute{
+				Description: "Version of the workflow as last applied by Terraform: its versionId, or its update timestamp on n8n versions without one. " +
+					"Unlike updated_at, this is not refreshed, and is compared with the workflow in n8n when overwrite_remote_changes is false.",
+				Computed: true,
 			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the workflow was created",
@@ -100,22 +295,73 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Timestamp when the workflow was last updated",
 				Computed:    true,
 			},
+			"ignore_server_fields": schema.SetAttribute{
+				Description: "Node fields that n8n fills in on its own and that are left out of state unless the configuration sets them for that node. " +
+					"Defaults to [\"id\", \"webhookId\"]. Workflow-level fields such as versionId, meta and pinData are never stored in state.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, stringValues(defaultIgnoredServerFields))),
+			},
+			"ignore_node_positions": schema.BoolAttribute{
+				Description: "When true, node positions changed in the n8n editor are not reported as changes and are kept when the workflow is updated. " +
+					"Positions in the configuration are then only used for new nodes. Defaults to false.",
+				Optional: true,
+			},
+			"owner_project_id": schema.StringAttribute{
+				Description: ownerProjectIDDescription,
+				Computed:    true,
+			},
+			"owner_email": schema.StringAttribute{
+				Description: ownerEmailDescription,
+				Computed:    true,
+			},
+			"shared_with": schema.SetAttribute{
+				Description: sharedWithDescription,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"node_count": schema.Int64Attribute{
+				Description: nodeCountDescription,
+				Computed:    true,
+			},
+			"trigger_types": schema.SetAttribute{
+				Description: triggerTypesDescription,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"uses_credentials": schema.SetAttribute{
+				Description: usesCredentialsDescription,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"has_webhook": schema.BoolAttribute{
+				Description: hasWebhookDescription,
+				Computed:    true,
+			},
+			"webhook_urls": webhookURLsSchemaAttribute(),
 		},
+		Blocks: workflowBlocksSchema(ctx),
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *workflowResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("Workflow identifier")
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *workflowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(workflowResourceClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -124,6 +370,227 @@ func (r *workflowResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+// ConfigValidators returns the validators for combinations of attributes.
+func (r *workflowResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// A workflow is defined either by workflow_json or by its name, nodes
+		// and connections (or node and connect blocks). A name next to
+		// workflow_json is accepted; the name in the JSON is used.
+		resourcevalidator.AtLeastOneOf(path.MatchRoot("workflow_json"), path.MatchRoot("name")),
+		resourcevalidator.Conflicting(path.MatchRoot("workflow_json"), path.MatchRoot("nodes")),
+		resourcevalidator.Conflicting(path.MatchRoot("workflow_json"), path.MatchRoot("connections")),
+		resourcevalidator.RequiredTogether(path.MatchRoot("nodes"), path.MatchRoot("connections")),
+		resourcevalidator.Conflicting(path.MatchRoot("pin_data"), path.MatchRoot("exclude_pinned_data")),
+		resourcevalidator.Conflicting(path.MatchRoot("tag_ids"), path.MatchRoot("tag_names")),
+		resourcevalidator.Conflicting(path.MatchRoot("tags"), path.MatchRoot("tag_ids")),
+		resourcevalidator.Conflicting(path.MatchRoot("tags"), path.MatchRoot("tag_names")),
+	}
+}
+
+// ValidateConfig checks the structure of the workflow definition, so that
+// mistakes are reported by terraform plan instead of halfway through an apply.
+func (r *workflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var workflowJSON, nodesJSON, connectionsJSON normalizedJSONValue
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("workflow_json"), &workflowJSON)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("nodes"), &nodesJSON)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connections"), &connectionsJSON)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !workflowJSON.IsNull() && !workflowJSON.IsUnknown() && workflowJSON.ValueString() != "" {
+		for _, problem := range workflowJSONProblems(workflowJSON.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("workflow_json"), "Invalid workflow_json", problem)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Check the credentials the nodes reference once credential_mappings
+		// is applied, as they are submitted
+		if nodes := plannedWorkflowJSONNodes(ctx, req.Config, &resp.Diagnostics); nodes != nil {
+			validateCredentialAllowlist(ctx, req.Config, nodes, path.Root("workflow_json"), &resp.Diagnostics)
+		}
+		return
+	}
+
+	// Without workflow_json, a name alone does not define a workflow
+	if workflowJSON.IsNull() && nodesJSON.IsNull() {
+		var name types.String
+		var nodeBlocks types.List
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("node"), &nodeBlocks)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !name.IsNull() && !nodeBlocks.IsUnknown() && len(nodeBlocks.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Missing Workflow Definition",
+				"A workflow needs nodes and connections, node blocks, or workflow_json in addition to its name.",
+			)
+		}
+		return
+	}
+
+	// The graph can only be checked once both halves are known
+	if nodesJSON.IsNull() || nodesJSON.IsUnknown() || connectionsJSON.IsNull() || connectionsJSON.IsUnknown() {
+		return
+	}
+
+	var nodes []interface{}
+	if err := json.Unmarshal([]byte(nodesJSON.ValueString()), &nodes); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("nodes"), "Invalid nodes", "nodes must be a JSON array: "+err.Error())
+	}
+	var connections map[string]interface{}
+	if err := json.Unmarshal([]byte(connectionsJSON.ValueString()), &connections); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("connections"), "Invalid connections", "connections must be a JSON object: "+err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, problem := range workflowGraphProblems(nodes, connections) {
+		resp.Diagnostics.AddAttributeError(path.Root("connections"), "Invalid workflow definition", problem)
+	}
+
+	validateCredentialAllowlist(ctx, req.Config, nodes, path.Root("nodes"), &resp.Diagnostics)
+}
+
+// validateCredentialAllowlist checks the credentials the nodes reference
+// against credential_allowlist when validate_credentials is set. This needs
+// no API; lookups on the instance happen at apply time.
+func validateCredentialAllowlist(ctx context.Context, config tfsdk.Config, nodes []interface{}, nodesPath path.Path, diags *diag.Diagnostics) {
+	var validateCredentials types.Bool
+	var allowlist types.Set
+	diags.Append(config.GetAttribute(ctx, path.Root("validate_credentials"), &validateCredentials)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("credential_allowlist"), &allowlist)...)
+	if diags.HasError() || !validateCredentials.ValueBool() || allowlist.IsNull() || allowlist.IsUnknown() {
+		return
+	}
+
+	var ids []types.String
+	diags.Append(allowlist.ElementsAs(ctx, &ids, false)...)
+	known := map[string]bool{}
+	for _, id := range ids {
+		if id.IsUnknown() {
+			return
+		}
+		known[id.ValueString()] = true
+	}
+	for _, problem := range missingCredentialProblems(nodes, known) {
+		diags.AddAttributeError(nodesPath, "Missing n8n Credential", "The workflow cannot be deployed because "+problem+".")
+	}
+}
+
+// ModifyPlan plans the nodes of workflow_json with credential_mappings
+// applied and checks the node types of the workflow against those installed
+// on the instance when validate_node_types is set.
+func (r *workflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Show the nodes that will be submitted, with the credential references
+	// mapped, instead of leaving them unknown until the apply
+	var plannedNodes normalizedJSONValue
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("nodes"), &plannedNodes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plannedNodes.IsUnknown() {
+		if nodes := plannedWorkflowJSONNodes(ctx, req.Config, &resp.Diagnostics); nodes != nil {
+			nodesJSON, err := json.Marshal(nodes)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error marshaling nodes",
+					"Could not marshal nodes to JSON: "+err.Error(),
+				)
+				return
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("nodes"), normalizedJSONString(string(nodesJSON)))...)
+		}
+	}
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	var validateNodeTypes types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validate_node_types"), &validateNodeTypes)...)
+	if resp.Diagnostics.HasError() || !validateNodeTypes.ValueBool() {
+		return
+	}
+
+	nodes, nodesPath := configuredWorkflowNodes(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || nodes == nil {
+		return
+	}
+
+	nodeTypes, err := withContext(r.client, ctx).ListNodeTypes()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validate_node_types"),
+			"Error Listing n8n Node Types",
+			"Could not list the node types installed on the instance: "+err.Error(),
+		)
+		return
+	}
+	installed := make(map[string][]float64, len(nodeTypes))
+	for _, nodeType := range nodeTypes {
+		installed[nodeType.Name] = append(installed[nodeType.Name], nodeType.Versions()...)
+	}
+
+	for _, problem := range unknownNodeTypeProblems(nodes, installed) {
+		resp.Diagnostics.AddAttributeError(nodesPath, "Unknown n8n Node Type", "The workflow cannot be deployed because "+problem+".")
+	}
+}
+
+// configuredWorkflowNodes returns the nodes of the workflow as far as they are
+// known from the configuration, and the path they are configured at. The
+// nodes are nil when they are not known yet.
+func configuredWorkflowNodes(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) ([]interface{}, path.Path) {
+	var workflowJSON, nodesJSON normalizedJSONValue
+	var blocks []workflowNodeBlockModel
+	diags.Append(config.GetAttribute(ctx, path.Root("workflow_json"), &workflowJSON)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("nodes"), &nodesJSON)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("node"), &blocks)...)
+	if diags.HasError() {
+		return nil, path.Empty()
+	}
+
+	switch {
+	case !workflowJSON.IsNull():
+		var workflowData map[string]interface{}
+		if workflowJSON.IsUnknown() || json.Unmarshal([]byte(workflowJSON.ValueString()), &workflowData) != nil {
+			return nil, path.Empty()
+		}
+		nodes, _ := workflowData["nodes"].([]interface{})
+		return nodes, path.Root("workflow_json")
+	case !nodesJSON.IsNull():
+		if nodesJSON.IsUnknown() {
+			return nil, path.Empty()
+		}
+		return decodeNodes(nodesJSON.ValueString()), path.Root("nodes")
+	default:
+		nodes := make([]interface{}, 0, len(blocks))
+		for _, block := range blocks {
+			node := map[string]interface{}{
+				"name":        block.Name.ValueString(),
+				"type":        block.Type.ValueString(),
+				"typeVersion": 1.0,
+			}
+			if block.TypeVersion.IsUnknown() {
+				delete(node, "typeVersion")
+			} else if !block.TypeVersion.IsNull() {
+				node["typeVersion"] = block.TypeVersion.ValueFloat64()
+			}
+			nodes = append(nodes, node)
+		}
+		return nodes, path.Root("node")
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *workflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -134,6 +601,29 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &workflowResource{client: withContext(r.client, opCtx)}
+
+	// workflow_json is only computed after an import
+	if plan.WorkflowJSON.IsUnknown() {
+		plan.WorkflowJSON = normalizedJSONNull()
+	}
+
+	// Render node and connect blocks into the nodes and connections JSON
+	if len(plan.Node) > 0 {
+		resp.Diagnostics.Append(expandWorkflowBlocks(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var name string
 	var active bool
 	var nodes []interface{}
@@ -143,26 +633,41 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Check if workflow_json is provided
 	if !plan.WorkflowJSON.IsNull() && plan.WorkflowJSON.ValueString() != "" {
-		// Parse the complete workflow JSON
-		var workflowData map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.WorkflowJSON.ValueString()), &workflowData); err != nil {
-			resp.Diagnostics.AddError(
-				"Error parsing workflow_json",
-				"Could not parse workflow_json: "+err.Error(),
-			)
-			return
+		// Substitute the template variables
+		workflowJSON := plan.WorkflowJSON.ValueString()
+		if !plan.TemplateVars.IsNull() || templateVarPattern.MatchString(workflowJSON) {
+			var vars map[string]string
+			if !plan.TemplateVars.IsNull() {
+				resp.Diagnostics.Append(plan.TemplateVars.ElementsAs(ctx, &vars, false)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+			rendered, err := renderTemplateVars(workflowJSON, vars)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("template_vars"),
+					"Error substituting template variables",
+					err.Error(),
+				)
+				return
+			}
+			workflowJSON = rendered
 		}
 
-		// Extract name
-		if nameVal, ok := workflowData["name"].(string); ok {
-			name = nameVal
-		} else {
-			resp.Diagnostics.AddError(
-				"Missing required field",
-				"workflow_json must contain a 'name' field",
-			)
+		// Parse the complete workflow JSON. The same checks run at plan
+		// time, but the document may only be known now.
+		doc, problems := parseWorkflowJSON(workflowJSON)
+		for _, problem := range problems {
+			resp.Diagnostics.AddAttributeError(path.Root("workflow_json"), "Invalid workflow_json", problem)
+		}
+		if resp.Diagnostics.HasError() {
 			return
 		}
+		workflowData := doc.data
+		name = doc.name
+		nodes = doc.nodes
+		connections = doc.connections
 
 		// Extract active (default to false if not present)
 		if activeVal, ok := workflowData["active"].(bool); ok {
@@ -171,26 +676,14 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 			active = false
 		}
 
-		// Extract nodes
-		if nodesVal, ok := workflowData["nodes"].([]interface{}); ok {
-			nodes = nodesVal
-		} else {
-			resp.Diagnostics.AddError(
-				"Missing required field",
-				"workflow_json must contain a 'nodes' array",
-			)
-			return
-		}
-
-		// Extract connections
-		if connectionsVal, ok := workflowData["connections"].(map[string]interface{}); ok {
-			connections = connectionsVal
-		} else {
-			resp.Diagnostics.AddError(
-				"Missing required field",
-				"workflow_json must contain a 'connections' object",
-			)
-			return
+		// Point the credential references at the credentials of this instance
+		if !plan.CredentialMappings.IsNull() {
+			var mappings map[string]string
+			resp.Diagnostics.Append(plan.CredentialMappings.ElementsAs(ctx, &mappings, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			mapWorkflowCredentials(nodes, mappings)
 		}
 
 		// Extract settings (optional)
@@ -226,7 +719,7 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 			)
 			return
 		}
-		plan.Nodes = types.StringValue(string(nodesJSON))
+		plan.Nodes = normalizedJSONString(string(nodesJSON))
 
 		connectionsJSON, err := json.Marshal(connections)
 		if err != nil {
@@ -236,7 +729,7 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 			)
 			return
 		}
-		plan.Connections = types.StringValue(string(connectionsJSON))
+		plan.Connections = normalizedJSONString(string(connectionsJSON))
 
 		if settings != nil {
 			settingsJSON, err := json.Marshal(settings)
@@ -247,7 +740,7 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 				)
 				return
 			}
-			plan.Settings = types.StringValue(string(settingsJSON))
+			plan.Settings = normalizedJSONString(string(settingsJSON))
 		}
 
 		if tags != nil {
@@ -312,6 +805,19 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
+	if plan.ValidateCredentials.ValueBool() {
+		resp.Diagnostics.Append(r.validateCredentials(ctx, &plan, nodes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	pinData, diags := decodePinData(plan.PinData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new workflow
 	workflow := &client.Workflow{
 		Name:        name,
@@ -320,45 +826,504 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 		Connections: connections,
 		Settings:    settings,
 		Tags:        tags,
+		// Create the workflow in its project right away; older n8n versions
+		// ignore this and the workflow is transferred below
+		ProjectID: plan.ProjectID.ValueString(),
+		PinData:   pinData,
+	}
+
+	var createdWorkflow *client.Workflow
+	var err error
+
+	// Adopt an existing workflow with the same name instead of creating a duplicate
+	var existingID string
+	if plan.AdoptExisting.ValueBool() {
+		existingID, err = r.findWorkflowIDByName(name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adopting existing workflow",
+				"Could not look up existing workflows named "+name+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if existingID != "" {
+		createdWorkflow, err = r.client.UpdateWorkflow(existingID, workflow)
+		if err != nil {
+			addWorkflowError(
+				&resp.Diagnostics,
+				"Error adopting existing workflow",
+				"Could not update existing workflow ID "+existingID+" to match the configuration: "+err.Error(),
+				err,
+			)
+			return
+		}
+		createdWorkflow.ID = existingID
+	} else {
+		createdWorkflow, err = r.client.CreateWorkflow(workflow)
+		if err != nil {
+			addWorkflowError(
+				&resp.Diagnostics,
+				"Error creating workflow",
+				"Could not create workflow, unexpected error: "+err.Error(),
+				err,
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(createdWorkflow.ID)
+	plan.CreatedAt = types.StringValue(createdWorkflow.CreatedAt)
+	plan.UpdatedAt = types.StringValue(createdWorkflow.UpdatedAt)
+	// n8n may assign the webhook IDs the URLs are made of, so use its nodes
+	plan.WebhookURLs, diags = workflowWebhookURLs(r.client.WebhookEndpoint(), createdWorkflow.Nodes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.NodeCount, plan.TriggerTypes, plan.UsesCredentials, plan.HasWebhook = workflowNodeSummary(nodes)
+	plan.OwnerProjectID, plan.OwnerEmail, plan.SharedWith = workflowOwnership(createdWorkflow)
+	plan.AppliedVersion = types.StringValue(workflowVersion(createdWorkflow))
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+	plan.StaticData, diags = workflowStaticData(createdWorkflow, plan.IncludeStaticData.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure tags is set (even if empty)
+	if plan.Tags.IsNull() || plan.Tags.IsUnknown() {
+		if len(createdWorkflow.Tags) > 0 {
+			tagsJSON, err := json.Marshal(createdWorkflow.Tags)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error marshaling tags",
+					"Could not marshal tags to JSON: "+err.Error(),
+				)
+				return
+			}
+			plan.Tags = types.StringValue(string(tagsJSON))
+		} else {
+			plan.Tags = types.StringValue("[]")
+		}
+	}
+
+	// Move the workflow into its project unless it was created there
+	ownerProjectID := createdWorkflow.OwnerProjectID()
+	if !plan.ProjectID.IsNull() && ownerProjectID == "" {
+		// The create response does not always include sharing details
+		current, err := r.client.GetWorkflow(createdWorkflow.ID)
+		if err == nil {
+			ownerProjectID = current.OwnerProjectID()
+		}
+	}
+	if !plan.ProjectID.IsNull() && plan.ProjectID.ValueString() != ownerProjectID {
+		if err := r.client.TransferWorkflow(createdWorkflow.ID, plan.ProjectID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Transferring n8n Workflow",
+				"Workflow ID "+createdWorkflow.ID+" was created but could not be transferred to project "+plan.ProjectID.ValueString()+": "+err.Error(),
+			)
+			// Keep the created workflow in state so it is not orphaned
+			plan.ProjectID = types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	// Move the workflow into its folder once it is in the folder's project
+	if !plan.FolderID.IsNull() {
+		workflow.ParentFolderID = plan.FolderID.ValueString()
+		movedWorkflow, err := r.client.UpdateWorkflow(createdWorkflow.ID, workflow)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Moving n8n Workflow",
+				"Workflow ID "+createdWorkflow.ID+" was created but could not be moved to folder "+plan.FolderID.ValueString()+": "+err.Error(),
+			)
+			// Keep the created workflow in state so it is not orphaned
+			plan.FolderID = types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+		plan.UpdatedAt = types.StringValue(movedWorkflow.UpdatedAt)
+	}
+
+	// Tag the workflow
+	if !plan.TagIDs.IsNull() || !plan.TagNames.IsNull() {
+		resp.Diagnostics.Append(r.applyTags(ctx, createdWorkflow.ID, &plan)...)
+		if resp.Diagnostics.HasError() {
+			// Keep the created workflow in state so it is not orphaned
+			plan.TagIDs = types.SetNull(types.StringType)
+			plan.TagNames = types.SetNull(types.StringType)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	// Activate the workflow once it is in place
+	if !plan.Active.IsNull() {
+		if err := r.syncWorkflowActive(createdWorkflow.ID, createdWorkflow.Active, plan.Active.ValueBool()); err != nil {
+			addWorkflowError(
+				&resp.Diagnostics,
+				"Error Changing n8n Workflow Activation",
+				"Workflow ID "+createdWorkflow.ID+" was created but its activation state could not be changed: "+err.Error(),
+				err,
+			)
+			// Keep the created workflow in state so it is not orphaned
+			plan.Active = types.BoolValue(createdWorkflow.Active)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	// Record the owner and version once the workflow is in place; the create
+	// response does not always include the owner
+	if current, err := r.client.GetWorkflow(createdWorkflow.ID); err == nil {
+		plan.OwnerProjectID, plan.OwnerEmail, plan.SharedWith = workflowOwnership(current)
+		plan.AppliedVersion = types.StringValue(workflowVersion(current))
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// workflowChanges lists the parts of a workflow update that differ from the
+// prior state, so that only those are sent to n8n.
+type workflowChanges struct {
+	// content covers the name, nodes, connections and settings, which the
+	// n8n API only accepts together
+	content bool
+	pinData bool
+	folder  bool
+	tags    bool
+}
+
+// sendsWorkflow reports whether the update needs to replace the workflow
+func (c workflowChanges) sendsWorkflow() bool {
+	return c.content || c.pinData || c.folder
+}
+
+// workflowChangesFrom compares a workflow update with the prior state. With
+// ignorePositions, nodes that only moved do not count as changed.
+func workflowChangesFrom(state *workflowResourceModel, workflow *client.Workflow, folderID types.String, ignorePositions bool) workflowChanges {
+	nodes := workflow.Nodes
+	if ignorePositions {
+		nodes = make([]interface{}, 0, len(workflow.Nodes))
+		for _, n := range workflow.Nodes {
+			if node, ok := n.(map[string]interface{}); ok {
+				n = maps.Clone(node)
+			}
+			nodes = append(nodes, n)
+		}
+		copyNodeField(nodes, decodeNodes(state.Nodes.ValueString()), "position")
+	}
+
+	return workflowChanges{
+		content: workflow.Name != state.Name.ValueString() ||
+			!state.Nodes.equalsJSON(nodes) ||
+			!state.Connections.equalsJSON(workflow.Connections) ||
+			(workflow.Settings != nil && !state.Settings.equalsJSON(workflow.Settings)),
+		pinData: workflow.PinData != nil && !state.PinData.equalsJSON(workflow.PinData),
+		folder:  !folderID.IsNull() && !folderID.Equal(state.FolderID),
+		tags:    workflowTagsChanged(state.Tags, workflow.Tags),
+	}
+}
+
+// workflowTagsChanged reports whether tags from workflow_json or the tags
+// attribute assign other tags than the prior state records. Only tags with
+// an ID are assigned, so tags without one are not compared.
+func workflowTagsChanged(prior types.String, tags []map[string]string) bool {
+	desired := workflowTagIDs(tags)
+	if len(desired) == 0 {
+		return false
+	}
+
+	var priorTags []map[string]interface{}
+	if err := json.Unmarshal([]byte(prior.ValueString()), &priorTags); err != nil {
+		return true
+	}
+	assigned := make(map[string]bool, len(priorTags))
+	for _, tag := range priorTags {
+		if id, ok := tag["id"].(string); ok && id != "" {
+			assigned[id] = true
+		}
+	}
+	return !maps.Equal(desired, assigned)
+}
+
+// workflowTagIDs returns the IDs of the tags that have one
+func workflowTagIDs(tags []map[string]string) map[string]bool {
+	ids := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if id := tag["id"]; id != "" {
+			ids[id] = true
+		}
+	}
+	return ids
+}
+
+// syncWorkflowActive activates or deactivates a workflow whose activation
+// state differs from the desired one.
+func (r *workflowResource) syncWorkflowActive(id string, active, desired bool) error {
+	if active == desired {
+		return nil
+	}
+
+	var err error
+	if desired {
+		_, err = r.client.ActivateWorkflow(id)
+	} else {
+		_, err = r.client.DeactivateWorkflow(id)
+	}
+	return err
+}
+
+// applyTags replaces the tags of a workflow with those of tag_ids or
+// tag_names and records them in the tags attribute of the plan.
+func (r *workflowResource) applyTags(ctx context.Context, id string, plan *workflowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var tagIDs []string
+	if !plan.TagIDs.IsNull() {
+		diags.Append(plan.TagIDs.ElementsAs(ctx, &tagIDs, false)...)
+	} else {
+		var names []string
+		diags.Append(plan.TagNames.ElementsAs(ctx, &names, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var err error
+		tagIDs, err = resolveTagIDs(r.client, names, plan.CreateMissingTags.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("tag_names"),
+				"Error Resolving n8n Tags",
+				err.Error(),
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	tags, err := r.client.SetWorkflowTags(id, tagIDs)
+	if err != nil {
+		diags.AddError(
+			"Error Tagging Workflow",
+			"Could not set tags of workflow ID "+id+": "+err.Error(),
+		)
+		return diags
+	}
+
+	tagsJSON, err := json.Marshal(tags)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling tags",
+			"Could not marshal tags to JSON: "+err.Error(),
+		)
+		return diags
+	}
+	plan.Tags = types.StringValue(string(tagsJSON))
+
+	return diags
+}
+
+// validateCredentials checks that the credentials the nodes reference are in
+// the credential allowlist or, without one, exist on the instance.
+func (r *workflowResource) validateCredentials(ctx context.Context, plan *workflowResourceModel, nodes []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	known := map[string]bool{}
+	if !plan.CredentialAllowlist.IsNull() {
+		var ids []string
+		diags.Append(plan.CredentialAllowlist.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		for _, id := range ids {
+			known[id] = true
+		}
+	} else {
+		credentials, err := r.client.ListCredentials()
+		if err != nil {
+			diags.AddError(
+				"Error Listing n8n Credentials",
+				"Could not list credentials to validate the credential references of the workflow "+
+					"(set credential_allowlist if the API key cannot list credentials): "+err.Error(),
+			)
+			return diags
+		}
+		for _, credential := range credentials {
+			known[credential.ID] = true
+		}
+	}
+
+	for _, problem := range missingCredentialProblems(nodes, known) {
+		diags.AddError("Missing n8n Credential", "The workflow cannot be deployed because "+problem+".")
+	}
+	return diags
+}
+
+// decodePinData decodes the pin_data attribute, returning nil when it is not
+// set so that the pinned data of the workflow is left alone.
+func decodePinData(value normalizedJSONValue) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	pinData := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &pinData); err != nil {
+		diags.AddAttributeError(
+			path.Root("pin_data"),
+			"Error parsing pin_data JSON",
+			"pin_data must be a JSON object keyed by node name: "+err.Error(),
+		)
+	}
+	return pinData, diags
+}
+
+// workflowVersion returns the versionId of a workflow, or its update
+// timestamp on n8n versions that do not report one.
+func workflowVersion(workflow *client.Workflow) string {
+	if workflow.VersionID != "" {
+		return workflow.VersionID
+	}
+	return workflow.UpdatedAt
+}
+
+// workflowStaticData returns the static_data attribute of a workflow, which
+// is null unless include is set.
+func workflowStaticData(workflow *client.Workflow, include bool) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !include {
+		return types.StringNull(), diags
+	}
+
+	staticData := workflow.StaticData
+	if staticData == nil {
+		staticData = map[string]interface{}{}
+	}
+	staticDataJSON, err := json.Marshal(staticData)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling static data",
+			"Could not marshal static data to JSON: "+err.Error(),
+		)
+		return types.StringNull(), diags
+	}
+	return types.StringValue(string(staticDataJSON)), diags
+}
+
+// workflowDrifted reports whether the name, nodes, connections, or settings
+// of a refreshed state differ from the prior state.
+func workflowDrifted(prior, refreshed *workflowResourceModel) bool {
+	if !prior.Name.Equal(refreshed.Name) {
+		return true
+	}
+
+	for _, values := range [][2]normalizedJSONValue{
+		{prior.Nodes, refreshed.Nodes},
+		{prior.Connections, refreshed.Connections},
+		{prior.Settings, refreshed.Settings},
+	} {
+		if values[0].IsNull() || values[1].IsNull() {
+			if values[0].IsNull() != values[1].IsNull() {
+				return true
+			}
+			continue
+		}
+		if equal, _ := values[0].StringSemanticEquals(context.Background(), values[1]); !equal {
+			return true
+		}
+	}
+
+	return false
+}
+
+// workflowJSONFromState returns a workflow_json document with the name,
+// nodes, connections, and settings of a refreshed state.
+func workflowJSONFromState(state *workflowResourceModel) (normalizedJSONValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	document := map[string]interface{}{
+		"name":        state.Name.ValueString(),
+		"nodes":       json.RawMessage(state.Nodes.ValueString()),
+		"connections": json.RawMessage(state.Connections.ValueString()),
+	}
+	if !state.Settings.IsNull() {
+		document["settings"] = json.RawMessage(state.Settings.ValueString())
 	}
 
-	createdWorkflow, err := r.client.CreateWorkflow(workflow)
+	documentJSON, err := json.Marshal(document)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating workflow",
-			"Could not create workflow, unexpected error: "+err.Error(),
+		diags.AddError(
+			"Error marshaling workflow JSON",
+			"Could not marshal workflow to JSON: "+err.Error(),
 		)
-		return
+		return normalizedJSONNull(), diags
 	}
+	return normalizedJSONString(string(documentJSON)), diags
+}
 
-	// Map response body to schema and populate Computed attribute values
-	plan.ID = types.StringValue(createdWorkflow.ID)
-	plan.CreatedAt = types.StringValue(createdWorkflow.CreatedAt)
-	plan.UpdatedAt = types.StringValue(createdWorkflow.UpdatedAt)
+// resolveTagIDs returns the IDs of the tags with the given names, creating
+// the tags that do not exist yet when createMissing is set.
+func resolveTagIDs(c client.TagsService, names []string, createMissing bool) ([]string, error) {
+	tags, err := c.ListTags()
+	if err != nil {
+		return nil, fmt.Errorf("could not list tags: %w", err)
+	}
+	idsByName := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		idsByName[tag.Name] = tag.ID
+	}
 
-	// Ensure tags is set (even if empty)
-	if plan.Tags.IsNull() || plan.Tags.IsUnknown() {
-		if len(createdWorkflow.Tags) > 0 {
-			tagsJSON, err := json.Marshal(createdWorkflow.Tags)
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := idsByName[name]
+		if !ok {
+			if !createMissing {
+				return nil, fmt.Errorf("tag %q does not exist; create it first or set create_missing_tags = true", name)
+			}
+			tag, err := c.CreateTag(name)
 			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error marshaling tags",
-					"Could not marshal tags to JSON: "+err.Error(),
-				)
-				return
+				return nil, fmt.Errorf("could not create tag %q: %w", name, err)
 			}
-			plan.Tags = types.StringValue(string(tagsJSON))
-		} else {
-			plan.Tags = types.StringValue("[]")
+			id = tag.ID
 		}
+		ids = append(ids, id)
 	}
 
-	// Set state to fully populated data
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	return ids, nil
+}
+
+// findWorkflowIDByName returns the ID of the workflow with the given name, or
+// an empty string if there is none. More than one match is an error because
+// there is no way to tell which workflow should be adopted.
+func (r *workflowResource) findWorkflowIDByName(name string) (string, error) {
+	workflows, err := r.client.ListWorkflows()
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	for _, workflow := range workflows {
+		if workflow.Name != name || workflow.IsArchived {
+			continue
+		}
+		if id != "" {
+			return "", fmt.Errorf("found multiple workflows named %q (IDs %s and %s); rename them or import the intended one", name, id, workflow.ID)
+		}
+		id = workflow.ID
 	}
+
+	return id, nil
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -371,11 +1336,32 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	// Get refreshed workflow value from n8n
-	workflow, err := r.client.GetWorkflow(state.ID.ValueString())
+	// Bound the requests of this operation with its timeout
+	timeout, diags := state.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &workflowResource{client: withContext(r.client, opCtx)}
+
+	// Only the ID is known after an import
+	imported := state.Name.IsNull() && state.WorkflowJSON.IsNull()
+	prior := state
+
+	// Get refreshed workflow value from n8n. Pinned data is only downloaded
+	// when pin_data manages it, unless the provider is told to always read it.
+	var workflow *client.Workflow
+	var err error
+	if state.ExcludePinnedData.ValueBool() || (r.client.PinnedDataExcluded() && state.PinData.IsNull()) {
+		workflow, err = r.client.RefreshWorkflowExcludingPinnedData(state.ID.ValueString())
+	} else {
+		workflow, err = r.client.RefreshWorkflow(state.ID.ValueString())
+	}
 	if err != nil {
 		// Check if the workflow was deleted outside of Terraform (404 error)
-		if strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			// Remove from state - Terraform will recreate it on next apply
 			resp.State.RemoveResource(ctx)
 			return
@@ -388,14 +1374,65 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// An archived workflow is as good as deleted
+	if workflow.IsArchived {
+		resp.Diagnostics.AddWarning(
+			"n8n Workflow Archived",
+			"Workflow ID "+state.ID.ValueString()+" was archived outside of Terraform and is removed from state; "+
+				"a new workflow is created on the next apply unless it is restored in n8n and imported again.",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Not set after an import
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(workflowDestroyDelete)
+	}
+
 	// Overwrite items with refreshed state
 	state.Name = types.StringValue(workflow.Name)
-	// 	state.Active = types.BoolValue(workflow.Active)
+	if !state.Active.IsNull() || imported {
+		state.Active = types.BoolValue(workflow.Active)
+	}
 	state.CreatedAt = types.StringValue(workflow.CreatedAt)
 	state.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+	if projectID := workflow.OwnerProjectID(); projectID != "" && (!state.ProjectID.IsNull() || imported) {
+		state.ProjectID = types.StringValue(projectID)
+	}
+	if !state.FolderID.IsNull() || (imported && workflow.ParentFolderID != "") {
+		state.FolderID = types.StringValue(workflow.ParentFolderID)
+	}
+	state.OwnerProjectID, state.OwnerEmail, state.SharedWith = workflowOwnership(workflow)
+	if state.AppliedVersion.IsNull() {
+		// Not set after an import
+		state.AppliedVersion = types.StringValue(workflowVersion(workflow))
+	}
+	state.StaticData, diags = workflowStaticData(workflow, state.IncludeStaticData.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Leave out node fields n8n fills in on its own
+	var ignoredFields []string
+	if state.IgnoreServerFields.IsNull() {
+		// Not set after an import
+		ignoredFields = defaultIgnoredServerFields
+		state.IgnoreServerFields = types.SetValueMust(types.StringType, stringValues(defaultIgnoredServerFields))
+	} else {
+		resp.Diagnostics.Append(state.IgnoreServerFields.ElementsAs(ctx, &ignoredFields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	nodes := stripServerFields(workflow.Nodes, state.Nodes.ValueString(), ignoredFields)
+	if state.IgnoreNodePositions.ValueBool() {
+		copyNodeField(nodes, decodeNodes(state.Nodes.ValueString()), "position")
+	}
 
 	// Convert nodes to JSON string
-	nodesJSON, err := json.Marshal(workflow.Nodes)
+	nodesJSON, err := json.Marshal(nodes)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error marshaling nodes",
@@ -403,7 +1440,14 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		)
 		return
 	}
-	state.Nodes = types.StringValue(string(nodesJSON))
+	state.Nodes = normalizedJSONString(string(nodesJSON))
+
+	state.WebhookURLs, diags = workflowWebhookURLs(r.client.WebhookEndpoint(), workflow.Nodes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.NodeCount, state.TriggerTypes, state.UsesCredentials, state.HasWebhook = workflowNodeSummary(workflow.Nodes)
 
 	// Convert connections to JSON string
 	connectionsJSON, err := json.Marshal(workflow.Connections)
@@ -414,7 +1458,7 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		)
 		return
 	}
-	state.Connections = types.StringValue(string(connectionsJSON))
+	state.Connections = normalizedJSONString(string(connectionsJSON))
 
 	// Convert settings to JSON string
 	if workflow.Settings != nil {
@@ -426,7 +1470,24 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 			)
 			return
 		}
-		state.Settings = types.StringValue(string(settingsJSON))
+		state.Settings = normalizedJSONString(string(settingsJSON))
+	}
+
+	// Only managed pinned data is stored in state
+	if !state.PinData.IsNull() {
+		pinData := workflow.PinData
+		if pinData == nil {
+			pinData = map[string]interface{}{}
+		}
+		pinDataJSON, err := json.Marshal(pinData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshaling pin data",
+				"Could not marshal pin data to JSON: "+err.Error(),
+			)
+			return
+		}
+		state.PinData = normalizedJSONString(string(pinDataJSON))
 	}
 
 	// Convert tags to JSON string
@@ -444,6 +1505,42 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		// Set empty array for tags if none exist
 		state.Tags = types.StringValue("[]")
 	}
+	if !state.TagIDs.IsNull() || !state.TagNames.IsNull() || (imported && len(workflow.Tags) > 0) {
+		tagIDs := make([]string, 0, len(workflow.Tags))
+		tagNames := make([]string, 0, len(workflow.Tags))
+		for _, tag := range workflow.Tags {
+			tagIDs = append(tagIDs, tag["id"])
+			tagNames = append(tagNames, tag["name"])
+		}
+		if !state.TagIDs.IsNull() {
+			state.TagIDs = types.SetValueMust(types.StringType, stringValues(tagIDs))
+		}
+		if !state.TagNames.IsNull() || imported {
+			state.TagNames = types.SetValueMust(types.StringType, stringValues(tagNames))
+		}
+	}
+
+	// Describe imported workflows and changes made outside of Terraform in
+	// workflow_json, so they show up when it is compared with the configuration
+	if (imported && state.WorkflowJSON.IsNull()) || (!state.WorkflowJSON.IsNull() && workflowDrifted(&prior, &state)) {
+		state.WorkflowJSON, diags = workflowJSONFromState(&state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// A configuration generated from an import may only set workflow_json, so
+	// leave the attributes it conflicts with to the next refresh
+	if imported {
+		state.Name = types.StringNull()
+		state.Nodes = normalizedJSONNull()
+		state.Connections = normalizedJSONNull()
+		state.Settings = normalizedJSONNull()
+		state.Tags = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -463,6 +1560,29 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &workflowResource{client: withContext(r.client, opCtx)}
+
+	// workflow_json is only computed after an import
+	if plan.WorkflowJSON.IsUnknown() {
+		plan.WorkflowJSON = normalizedJSONNull()
+	}
+
+	// Render node and connect blocks into the nodes and connections JSON
+	if len(plan.Node) > 0 {
+		resp.Diagnostics.Append(expandWorkflowBlocks(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var name string
 	var active bool
 	var nodes []interface{}
@@ -472,26 +1592,41 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Check if workflow_json is provided
 	if !plan.WorkflowJSON.IsNull() && plan.WorkflowJSON.ValueString() != "" {
-		// Parse the complete workflow JSON
-		var workflowData map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.WorkflowJSON.ValueString()), &workflowData); err != nil {
-			resp.Diagnostics.AddError(
-				"Error parsing workflow_json",
-				"Could not parse workflow_json: "+err.Error(),
-			)
-			return
+		// Substitute the template variables
+		workflowJSON := plan.WorkflowJSON.ValueString()
+		if !plan.TemplateVars.IsNull() || templateVarPattern.MatchString(workflowJSON) {
+			var vars map[string]string
+			if !plan.TemplateVars.IsNull() {
+				resp.Diagnostics.Append(plan.TemplateVars.ElementsAs(ctx, &vars, false)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+			rendered, err := renderTemplateVars(workflowJSON, vars)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("template_vars"),
+					"Error substituting template variables",
+					err.Error(),
+				)
+				return
+			}
+			workflowJSON = rendered
 		}
 
-		// Extract name
-		if nameVal, ok := workflowData["name"].(string); ok {
-			name = nameVal
-		} else {
-			resp.Diagnostics.AddError(
-				"Missing required field",
-				"workflow_json must contain a 'name' field",
-			)
+		// Parse the complete workflow JSON. The same checks run at plan
+		// time, but the document may only be known now.
+		doc, problems := parseWorkflowJSON(workflowJSON)
+		for _, problem := range problems {
+			resp.Diagnostics.AddAttributeError(path.Root("workflow_json"), "Invalid workflow_json", problem)
+		}
+		if resp.Diagnostics.HasError() {
 			return
 		}
+		workflowData := doc.data
+		name = doc.name
+		nodes = doc.nodes
+		connections = doc.connections
 
 		// Extract active (default to false if not present)
 		if activeVal, ok := workflowData["active"].(bool); ok {
@@ -500,26 +1635,14 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 			active = false
 		}
 
-		// Extract nodes
-		if nodesVal, ok := workflowData["nodes"].([]interface{}); ok {
-			nodes = nodesVal
-		} else {
-			resp.Diagnostics.AddError(
-				"Missing required field",
-				"workflow_json must contain a 'nodes' array",
-			)
-			return
-		}
-
-		// Extract connections
-		if connectionsVal, ok := workflowData["connections"].(map[string]interface{}); ok {
-			connections = connectionsVal
-		} else {
-			resp.Diagnostics.AddError(
-				"Missing required field",
-				"workflow_json must contain a 'connections' object",
-			)
-			return
+		// Point the credential references at the credentials of this instance
+		if !plan.CredentialMappings.IsNull() {
+			var mappings map[string]string
+			resp.Diagnostics.Append(plan.CredentialMappings.ElementsAs(ctx, &mappings, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			mapWorkflowCredentials(nodes, mappings)
 		}
 
 		// Extract settings (optional)
@@ -555,7 +1678,7 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 			)
 			return
 		}
-		plan.Nodes = types.StringValue(string(nodesJSON))
+		plan.Nodes = normalizedJSONString(string(nodesJSON))
 
 		connectionsJSON, err := json.Marshal(connections)
 		if err != nil {
@@ -565,7 +1688,7 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 			)
 			return
 		}
-		plan.Connections = types.StringValue(string(connectionsJSON))
+		plan.Connections = normalizedJSONString(string(connectionsJSON))
 
 		if settings != nil {
 			settingsJSON, err := json.Marshal(settings)
@@ -576,7 +1699,7 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 				)
 				return
 			}
-			plan.Settings = types.StringValue(string(settingsJSON))
+			plan.Settings = normalizedJSONString(string(settingsJSON))
 		}
 
 		if tags != nil {
@@ -633,6 +1756,63 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
+	if plan.ValidateCredentials.ValueBool() {
+		resp.Diagnostics.Append(r.validateCredentials(ctx, &plan, nodes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Refuse to overwrite edits made outside of Terraform
+	if !plan.OverwriteRemoteChanges.IsNull() && !plan.OverwriteRemoteChanges.ValueBool() {
+		var appliedVersion types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("applied_version"), &appliedVersion)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		current, err := r.client.GetWorkflow(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading n8n Workflow",
+				"Could not read workflow ID "+plan.ID.ValueString()+" to check for changes made outside of Terraform: "+err.Error(),
+			)
+			return
+		}
+		if !appliedVersion.IsNull() && workflowVersion(current) != appliedVersion.ValueString() {
+			resp.Diagnostics.AddError(
+				"n8n Workflow Changed Outside of Terraform",
+				"Workflow ID "+plan.ID.ValueString()+" was edited in n8n (version "+workflowVersion(current)+") since Terraform last applied it (version "+
+					appliedVersion.ValueString()+"), and overwrite_remote_changes is false. "+
+					"Copy the changes into the configuration, or set overwrite_remote_changes to true to overwrite them.",
+			)
+			return
+		}
+	}
+
+	// Transfer the workflow when its project changed, before it is moved into
+	// a folder of the new project
+	var priorProjectID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_id"), &priorProjectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.Equal(priorProjectID) {
+		if err := r.client.TransferWorkflow(plan.ID.ValueString(), plan.ProjectID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Transferring n8n Workflow",
+				"Could not transfer workflow to project "+plan.ProjectID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	pinData, diags := decodePinData(plan.PinData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update existing workflow
 	workflow := &client.Workflow{
 		Name:        name,
@@ -641,20 +1821,112 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 		Connections: connections,
 		Settings:    settings,
 		Tags:        tags,
+		PinData:     pinData,
+	}
+
+	// The n8n API replaces the content of a workflow on every update, so
+	// the update is skipped when only attributes applied through other
+	// endpoints changed, and optional parts are only sent when they changed
+	var state workflowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	changes := workflowChangesFrom(&state, workflow, plan.FolderID, plan.IgnoreNodePositions.ValueBool())
+	if !changes.pinData {
+		workflow.PinData = nil
+	}
+	if changes.folder {
+		workflow.ParentFolderID = plan.FolderID.ValueString()
+	}
+
+	// Keep the node positions of the workflow as it is in n8n
+	if changes.sendsWorkflow() && plan.IgnoreNodePositions.ValueBool() {
+		current, err := r.client.GetWorkflow(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading n8n Workflow",
+				"Could not read current node positions of workflow ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		copyNodeField(nodes, current.Nodes, "position")
 	}
 
-	updatedWorkflow, err := r.client.UpdateWorkflow(plan.ID.ValueString(), workflow)
+	var updatedWorkflow *client.Workflow
+	var err error
+	switch {
+	case changes.sendsWorkflow():
+		if !changes.tags {
+			workflow.Tags = nil
+		}
+		updatedWorkflow, err = r.client.UpdateWorkflow(plan.ID.ValueString(), workflow)
+	case changes.tags:
+		err = r.client.UpdateWorkflowTags(plan.ID.ValueString(), tags)
+		if err == nil {
+			updatedWorkflow, err = r.client.GetWorkflow(plan.ID.ValueString())
+		}
+	default:
+		updatedWorkflow, err = r.client.GetWorkflow(plan.ID.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addWorkflowError(
+			&resp.Diagnostics,
 			"Error Updating n8n Workflow",
 			"Could not update workflow, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
 
+	// Tags with IDs are assigned now or were already, so they are recorded
+	// as configured
+	if len(workflowTagIDs(tags)) > 0 {
+		updatedWorkflow.Tags = tags
+	}
+
+	// Register the triggers of an active workflow anew
+	if changes.sendsWorkflow() && plan.ReactivateOnUpdate.ValueBool() && updatedWorkflow.Active && (plan.Active.IsNull() || plan.Active.ValueBool()) {
+		if _, err := r.client.DeactivateWorkflow(plan.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reactivating n8n Workflow",
+				"Workflow ID "+plan.ID.ValueString()+" was updated but could not be deactivated: "+err.Error(),
+			)
+			return
+		}
+		if _, err := r.client.ActivateWorkflow(plan.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reactivating n8n Workflow",
+				"Workflow ID "+plan.ID.ValueString()+" was updated and deactivated but could not be activated again: "+err.Error(),
+			)
+			return
+		}
+	} else if !plan.Active.IsNull() {
+		if err := r.syncWorkflowActive(plan.ID.ValueString(), updatedWorkflow.Active, plan.Active.ValueBool()); err != nil {
+			addWorkflowError(
+				&resp.Diagnostics,
+				"Error Changing n8n Workflow Activation",
+				"Workflow ID "+plan.ID.ValueString()+" was updated but its activation state could not be changed: "+err.Error(),
+				err,
+			)
+			return
+		}
+	}
+
 	// Update resource state with updated items and timestamps
 	plan.CreatedAt = types.StringValue(updatedWorkflow.CreatedAt)
 	plan.UpdatedAt = types.StringValue(updatedWorkflow.UpdatedAt)
+	plan.StaticData, diags = workflowStaticData(updatedWorkflow, plan.IncludeStaticData.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.WebhookURLs, diags = workflowWebhookURLs(r.client.WebhookEndpoint(), updatedWorkflow.Nodes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.NodeCount, plan.TriggerTypes, plan.UsesCredentials, plan.HasWebhook = workflowNodeSummary(nodes)
 
 	// Ensure tags is set (even if empty)
 	if len(updatedWorkflow.Tags) > 0 {
@@ -671,6 +1943,23 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 		plan.Tags = types.StringValue("[]")
 	}
 
+	if !plan.TagIDs.IsNull() || !plan.TagNames.IsNull() {
+		resp.Diagnostics.Append(r.applyTags(ctx, plan.ID.ValueString(), &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Record the owner and version after a possible transfer
+	applied := updatedWorkflow
+	if current, err := r.client.GetWorkflow(plan.ID.ValueString()); err == nil {
+		applied = current
+	}
+	plan.OwnerProjectID, plan.OwnerEmail, plan.SharedWith = workflowOwnership(applied)
+	plan.AppliedVersion = types.StringValue(workflowVersion(applied))
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -688,19 +1977,102 @@ func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Bound the requests of this operation with its timeout
+	timeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, cancel := withOperationTimeout(ctx, timeout)
+	defer cancel()
+	r = &workflowResource{client: withContext(r.client, opCtx)}
+
+	// Check whether the workflow is still serving requests
+	if state.PreventDestroyWhenActive.ValueBool() || state.DeactivateBeforeDelete.ValueBool() {
+		workflow, err := r.client.GetWorkflow(state.ID.ValueString())
+		if err != nil {
+			// Already deleted outside of Terraform
+			if client.IsNotFound(err) {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading n8n Workflow",
+				"Could not read workflow ID "+state.ID.ValueString()+" before deleting it: "+err.Error(),
+			)
+			return
+		}
+
+		if workflow.Active && state.PreventDestroyWhenActive.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Active n8n Workflow Not Destroyed",
+				"Workflow ID "+state.ID.ValueString()+" is active and prevent_destroy_when_active is true. "+
+					"Deactivate the workflow first, or set prevent_destroy_when_active to false and apply before destroying it.",
+			)
+			return
+		}
+
+		if workflow.Active {
+			if _, err := r.client.DeactivateWorkflow(state.ID.ValueString()); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Deactivating n8n Workflow",
+					"Could not deactivate workflow ID "+state.ID.ValueString()+" before deleting it: "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	if state.OnDestroy.ValueString() == workflowDestroyArchive {
+		if err := r.client.ArchiveWorkflow(state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Archiving n8n Workflow",
+				"Could not archive workflow ID "+state.ID.ValueString()+" (archiving requires a recent n8n version): "+err.Error(),
+			)
+		}
+		return
+	}
+
 	// Delete existing workflow
-	err := r.client.DeleteWorkflow(state.ID.ValueString())
-	if err != nil {
+	if err := deleteWorkflow(r.client, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting n8n Workflow",
-			"Could not delete workflow, unexpected error: "+err.Error(),
+			"Could not delete workflow ID "+state.ID.ValueString()+": "+err.Error(),
 		)
-		return
 	}
 }
 
+// deleteWorkflow deletes a workflow permanently. n8n versions that archive
+// workflows on delete remove them on a second delete.
+func deleteWorkflow(c client.WorkflowsService, id string) error {
+	if err := c.DeleteWorkflow(id); err != nil {
+		return err
+	}
+
+	workflow, err := c.GetWorkflow(id)
+	if err == nil && workflow.IsArchived {
+		if err := c.DeleteWorkflow(id); err != nil {
+			return fmt.Errorf("the workflow was archived but could not be deleted permanently: %w", err)
+		}
+	}
+	return nil
+}
+
 // ImportState imports the resource state.
 func (r *workflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	id, diags := importedResourceID(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// stringValues converts strings to Terraform string values.
+func stringValues(values []string) []attr.Value {
+	result := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
 }
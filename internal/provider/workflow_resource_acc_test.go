@@ -0,0 +1,87 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// testAccWorkflowConfig is the configuration of a workflow with a schedule
+// trigger, so that it can be activated. Settings are left to
+// n8n_workflow_settings.
+func testAccWorkflowConfig(name string) string {
+	return fmt.Sprintf(`
+resource "n8n_workflow" "test" {
+  name = %q
+
+  nodes = jsonencode([
+    {
+      id          = "1"
+      name        = "Schedule Trigger"
+      type        = "n8n-nodes-base.scheduleTrigger"
+      typeVersion = 1.2
+      position    = [0, 0]
+      parameters = {
+        rule = {
+          interval = [{ field = "hours", hoursInterval = 1 }]
+        }
+      }
+    },
+    {
+      id          = "2"
+      name        = "No Operation"
+      type        = "n8n-nodes-base.noOp"
+      typeVersion = 1
+      position    = [200, 0]
+      parameters  = {}
+    }
+  ])
+
+  connections = jsonencode({
+    "Schedule Trigger" = {
+      main = [[{ node = "No Operation", type = "main", index = 0 }]]
+    }
+  })
+}
+`, name)
+}
+
+func TestAccWorkflowResource(t *testing.T) {
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("n8n_workflow.test", "id"),
+		resource.TestCheckResourceAttrSet("n8n_workflow.test", "owner_project_id"),
+		resource.TestCheckResourceAttrSet("n8n_workflow.test", "created_at"),
+		resource.TestCheckResourceAttr("n8n_workflow.test", "node_count", "2"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig("tf-acc workflow"),
+				Check:  check,
+			},
+			{
+				Config:           testAccWorkflowConfig("tf-acc workflow renamed"),
+				ConfigPlanChecks: testAccExpectAction("n8n_workflow.test", plancheck.ResourceActionUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					check,
+					resource.TestCheckResourceAttr("n8n_workflow.test", "name", "tf-acc workflow renamed"),
+				),
+			},
+			{
+				ResourceName:      "n8n_workflow.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imports describe the workflow in workflow_json and read the
+				// attributes that are left unset here
+				ImportStateVerifyIgnore: []string{"workflow_json", "active", "project_id"},
+			},
+		},
+	})
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// workflowConfig builds a workflow resource configuration with the given
+// root attributes set and everything else null.
+func workflowConfig(t *testing.T, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&workflowResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", schemaResp.Diagnostics)
+	}
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("schema type is not an object")
+	}
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	config, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	return &config
+}
+
+// nodeBlocks builds a list of node blocks with the given names.
+func nodeBlocks(t *testing.T, names ...string) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&workflowResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	listType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["node"].(tftypes.List)
+	if !ok {
+		t.Fatal("node block is not a list")
+	}
+	blockType, ok := listType.ElementType.(tftypes.Object)
+	if !ok {
+		t.Fatal("node block is not an object")
+	}
+
+	blocks := make([]tftypes.Value, 0, len(names))
+	for _, name := range names {
+		attributes := make(map[string]tftypes.Value, len(blockType.AttributeTypes))
+		for attribute, attributeType := range blockType.AttributeTypes {
+			attributes[attribute] = tftypes.NewValue(attributeType, nil)
+		}
+		attributes["name"] = tftypes.NewValue(tftypes.String, name)
+		attributes["type"] = tftypes.NewValue(tftypes.String, "n8n-nodes-base.noOp")
+		blocks = append(blocks, tftypes.NewValue(blockType, attributes))
+	}
+	return tftypes.NewValue(listType, blocks)
+}
+
+func TestWorkflowResourceConfigValidation(t *testing.T) {
+	str := func(value string) tftypes.Value { return tftypes.NewValue(tftypes.String, value) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	const workflowJSON = `{"name":"Orders","nodes":[],"connections":{}}`
+	const workflowJSONWithCredential = `{"name":"Orders","connections":{},"nodes":[{"name":"Slack","type":"n8n-nodes-base.slack","credentials":{"slackApi":{"id":"1","name":"Slack account"}}}]}`
+
+	tests := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr string
+	}{
+		"workflow_json": {
+			values: map[string]tftypes.Value{"workflow_json": str(workflowJSON)},
+		},
+		"workflow_json and name": {
+			values: map[string]tftypes.Value{"workflow_json": str(workflowJSON), "name": str("Orders")},
+		},
+		"name, nodes and connections": {
+			values: map[string]tftypes.Value{"name": str("Orders"), "nodes": str("[]"), "connections": str("{}")},
+		},
+		"name and node blocks": {
+			values: map[string]tftypes.Value{"name": str("Orders"), "node": nodeBlocks(t, "Start")},
+		},
+		"unknown nodes": {
+			values: map[string]tftypes.Value{"name": str("Orders"), "nodes": unknown, "connections": unknown},
+		},
+		"nothing": {
+			wantErr: "At least one of these attributes must be configured: [workflow_json,name]",
+		},
+		"name only": {
+			values:  map[string]tftypes.Value{"name": str("Orders")},
+			wantErr: "Missing Workflow Definition",
+		},
+		"nodes without connections": {
+			values:  map[string]tftypes.Value{"name": str("Orders"), "nodes": str("[]")},
+			wantErr: "must be configured together: [nodes,connections]",
+		},
+		"nodes without name": {
+			values:  map[string]tftypes.Value{"nodes": str("[]"), "connections": str("{}")},
+			wantErr: `"name" must be specified when "nodes" is specified`,
+		},
+		"node blocks without name": {
+			values:  map[string]tftypes.Value{"node": nodeBlocks(t, "Start")},
+			wantErr: `"name" must be specified when "node" is specified`,
+		},
+		"workflow_json with mapped credentials in the allowlist": {
+			values: map[string]tftypes.Value{
+				"workflow_json":        str(workflowJSONWithCredential),
+				"credential_mappings":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"Slack account": str("42")}),
+				"validate_credentials": tftypes.NewValue(tftypes.Bool, true),
+				"credential_allowlist": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{str("42")}),
+			},
+		},
+		"workflow_json with unmapped credentials": {
+			values: map[string]tftypes.Value{
+				"workflow_json":        str(workflowJSONWithCredential),
+				"validate_credentials": tftypes.NewValue(tftypes.Bool, true),
+				"credential_allowlist": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{str("42")}),
+			},
+			wantErr: `node "Slack" references slackApi credential "Slack account" (ID 1), which does not exist`,
+		},
+		"workflow_json and nodes": {
+			values:  map[string]tftypes.Value{"workflow_json": str(workflowJSON), "nodes": str("[]"), "connections": str("{}"), "name": str("Orders")},
+			wantErr: "cannot be configured together: [workflow_json,nodes]",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, err := providerserver.NewProtocol6WithError(New("test")())()
+			if err != nil {
+				t.Fatalf("provider server: %v", err)
+			}
+			resp, err := server.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+				TypeName: "n8n_workflow",
+				Config:   workflowConfig(t, tt.values),
+			})
+			if err != nil {
+				t.Fatalf("validate: %v", err)
+			}
+
+			var diags []string
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					diags = append(diags, d.Summary+": "+d.Detail)
+				}
+			}
+
+			got := strings.Join(diags, "\n")
+			switch {
+			case tt.wantErr == "" && got != "":
+				t.Errorf("unexpected errors:\n%s", got)
+			case tt.wantErr != "" && !strings.Contains(got, tt.wantErr):
+				t.Errorf("errors %q do not mention %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWorkflowChangesFrom(t *testing.T) {
+	const nodes = `[{"name":"Start","type":"n8n-nodes-base.manualTrigger","position":[0,0]}]`
+	state := &workflowResourceModel{
+		Name:        types.StringValue("Orders"),
+		Nodes:       normalizedJSONString(nodes),
+		Connections: normalizedJSONString(`{}`),
+		Settings:    normalizedJSONString(`{"timezone":"UTC"}`),
+		PinData:     normalizedJSONString(`{"Start":[]}`),
+		FolderID:    types.StringValue("f1"),
+		Tags:        types.StringValue(`[{"id":"t1","name":"prod","createdAt":"2026-01-01T00:00:00.000Z"}]`),
+	}
+
+	decode := func(value string) map[string]interface{} {
+		var decoded map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		return decoded
+	}
+	workflow := func(change func(*client.Workflow)) *client.Workflow {
+		w := &client.Workflow{
+			Name:        "Orders",
+			Nodes:       decodeNodes(nodes),
+			Connections: map[string]interface{}{},
+			Settings:    decode(`{"timezone":"UTC"}`),
+			PinData:     decode(`{"Start":[]}`),
+			Tags:        []map[string]string{{"id": "t1", "name": "prod"}},
+		}
+		if change != nil {
+			change(w)
+		}
+		return w
+	}
+	moved := func(w *client.Workflow) {
+		w.Nodes = decodeNodes(`[{"name":"Start","type":"n8n-nodes-base.manualTrigger","position":[200,100]}]`)
+	}
+
+	tests := map[string]struct {
+		workflow        *client.Workflow
+		folderID        types.String
+		ignorePositions bool
+		want            workflowChanges
+	}{
+		"unchanged": {
+			workflow: workflow(nil),
+			folderID: types.StringValue("f1"),
+		},
+		"renamed": {
+			workflow: workflow(func(w *client.Workflow) { w.Name = "Invoices" }),
+			want:     workflowChanges{content: true},
+		},
+		"moved node": {
+			workflow: workflow(moved),
+			want:     workflowChanges{content: true},
+		},
+		"moved node with ignored positions": {
+			workflow:        workflow(moved),
+			ignorePositions: true,
+		},
+		"changed node with ignored positions": {
+			workflow: workflow(func(w *client.Workflow) {
+				moved(w)
+				w.Nodes[0].(map[string]interface{})["disabled"] = true
+			}),
+			ignorePositions: true,
+			want:            workflowChanges{content: true},
+		},
+		"settings not managed": {
+			workflow: workflow(func(w *client.Workflow) { w.Settings = nil }),
+		},
+		"pinned data": {
+			workflow: workflow(func(w *client.Workflow) { w.PinData = decode(`{"Start":[{"json":{}}]}`) }),
+			want:     workflowChanges{pinData: true},
+		},
+		"folder": {
+			workflow: workflow(nil),
+			folderID: types.StringValue("f2"),
+			want:     workflowChanges{folder: true},
+		},
+		"tags": {
+			workflow: workflow(func(w *client.Workflow) { w.Tags = append(w.Tags, map[string]string{"id": "t2"}) }),
+			want:     workflowChanges{tags: true},
+		},
+		"tags without ids": {
+			workflow: workflow(func(w *client.Workflow) { w.Tags = []map[string]string{{"name": "dev"}} }),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := workflowChangesFrom(state, tt.workflow, tt.folderID, tt.ignorePositions)
+			if got != tt.want {
+				t.Errorf("workflowChangesFrom() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
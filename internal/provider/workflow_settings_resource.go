@@ -0,0 +1,427 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workflowSettingsResource{}
+	_ resource.ResourceWithConfigure   = &workflowSettingsResource{}
+	_ resource.ResourceWithImportState = &workflowSettingsResource{}
+)
+
+// workflowSettingKeys maps the attributes of n8n_workflow_settings to the
+// keys of the n8n workflow settings object.
+var workflowSettingKeys = map[string]string{
+	"error_workflow":              "errorWorkflow",
+	"timezone":                    "timezone",
+	"execution_order":             "executionOrder",
+	"save_data_error_execution":   "saveDataErrorExecution",
+	"save_data_success_execution": "saveDataSuccessExecution",
+	"save_manual_executions":      "saveManualExecutions",
+	"save_execution_progress":     "saveExecutionProgress",
+	"execution_timeout":           "executionTimeout",
+	"caller_policy":               "callerPolicy",
+}
+
+// NewWorkflowSettingsResource is a helper function to simplify the provider implementation.
+func NewWorkflowSettingsResource() resource.Resource {
+	return &workflowSettingsResource{}
+}
+
+// workflowSettingsResource is the resource implementation.
+type workflowSettingsResource struct {
+	client client.WorkflowsService
+}
+
+// workflowSettingsResourceModel maps the resource schema data.
+type workflowSettingsResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	WorkflowID               types.String `tfsdk:"workflow_id"`
+	ErrorWorkflow            types.String `tfsdk:"error_workflow"`
+	Timezone                 types.String `tfsdk:"timezone"`
+	ExecutionOrder           types.String `tfsdk:"execution_order"`
+	SaveDataErrorExecution   types.String `tfsdk:"save_data_error_execution"`
+	SaveDataSuccessExecution types.String `tfsdk:"save_data_success_execution"`
+	SaveManualExecutions     types.Bool   `tfsdk:"save_manual_executions"`
+	SaveExecutionProgress    types.Bool   `tfsdk:"save_execution_progress"`
+	ExecutionTimeout         types.Int64  `tfsdk:"execution_timeout"`
+	CallerPolicy             types.String `tfsdk:"caller_policy"`
+}
+
+// Metadata returns the resource type name.
+func (r *workflowSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *workflowSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of an n8n workflow with typed attributes. Only the settings set here are managed; " +
+			"other settings of the workflow are left untouched. Do not also set settings on the n8n_workflow resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier (same as workflow_id)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				Description: "The ID of the workflow to configure",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"error_workflow": schema.StringAttribute{
+				Description: "ID of the workflow to run when this workflow fails",
+				Optional:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "Timezone used by schedule triggers, e.g. 'Europe/Berlin'",
+				Optional:    true,
+			},
+			"execution_order": schema.StringAttribute{
+				Description: "Node execution order: 'v1' (recommended) or 'v0' (legacy)",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("v0", "v1"),
+				},
+			},
+			"save_data_error_execution": schema.StringAttribute{
+				Description: "Whether failed executions are saved: 'all' or 'none'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "none"),
+				},
+			},
+			"save_data_success_execution": schema.StringAttribute{
+				Description: "Whether successful executions are saved: 'all' or 'none'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "none"),
+				},
+			},
+			"save_manual_executions": schema.BoolAttribute{
+				Description: "Whether manual executions are saved",
+				Optional:    true,
+			},
+			"save_execution_progress": schema.BoolAttribute{
+				Description: "Whether execution progress is saved after each node, so failed executions can be resumed",
+				Optional:    true,
+			},
+			"execution_timeout": schema.Int64Attribute{
+				Description: "Maximum execution time in seconds, or -1 for no timeout",
+				Optional:    true,
+			},
+			"caller_policy": schema.StringAttribute{
+				Description: "Which workflows may call this workflow: 'any', 'none', 'workflowsFromSameOwner' or 'workflowsFromAList'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("any", "none", "workflowsFromSameOwner", "workflowsFromAList"),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workflowSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.WorkflowsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workflowSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowSettingsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan workflowSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applySettings(&plan, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Workflow Settings",
+			"Could not update settings of workflow ID "+plan.WorkflowID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set the ID to the workflow ID
+	plan.ID = plan.WorkflowID
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workflowSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowSettingsResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state workflowSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.RefreshWorkflowExcludingPinnedData(state.WorkflowID.ValueString())
+	if err != nil {
+		// Check if the workflow was deleted outside of Terraform (404 error)
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Workflow",
+			"Could not read workflow ID "+state.WorkflowID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only refresh managed settings; a managed setting removed in n8n becomes
+	// null. Nothing is managed after an import, so all settings are refreshed.
+	settings := workflow.Settings
+	imported := state.ErrorWorkflow.IsNull() &&
+		state.Timezone.IsNull() &&
+		state.ExecutionOrder.IsNull() &&
+		state.SaveDataErrorExecution.IsNull() &&
+		state.SaveDataSuccessExecution.IsNull() &&
+		state.SaveManualExecutions.IsNull() &&
+		state.SaveExecutionProgress.IsNull() &&
+		state.ExecutionTimeout.IsNull() &&
+		state.CallerPolicy.IsNull()
+	if !state.ErrorWorkflow.IsNull() || imported {
+		state.ErrorWorkflow = settingString(settings, "errorWorkflow")
+	}
+	if !state.Timezone.IsNull() || imported {
+		state.Timezone = settingString(settings, "timezone")
+	}
+	if !state.ExecutionOrder.IsNull() || imported {
+		state.ExecutionOrder = settingString(settings, "executionOrder")
+	}
+	if !state.SaveDataErrorExecution.IsNull() || imported {
+		state.SaveDataErrorExecution = settingString(settings, "saveDataErrorExecution")
+	}
+	if !state.SaveDataSuccessExecution.IsNull() || imported {
+		state.SaveDataSuccessExecution = settingString(settings, "saveDataSuccessExecution")
+	}
+	if !state.SaveManualExecutions.IsNull() || imported {
+		state.SaveManualExecutions = settingBool(settings, "saveManualExecutions")
+	}
+	if !state.SaveExecutionProgress.IsNull() || imported {
+		state.SaveExecutionProgress = settingBool(settings, "saveExecutionProgress")
+	}
+	if !state.ExecutionTimeout.IsNull() || imported {
+		state.ExecutionTimeout = settingInt64(settings, "executionTimeout")
+	}
+	if !state.CallerPolicy.IsNull() || imported {
+		state.CallerPolicy = settingString(settings, "callerPolicy")
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workflowSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowSettingsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan workflowSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state workflowSettingsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applySettings(&plan, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Workflow Settings",
+			"Could not update settings of workflow ID "+plan.WorkflowID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workflowSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowSettingsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state workflowSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the managed settings so n8n falls back to its defaults
+	if err := r.applySettings(&workflowSettingsResourceModel{WorkflowID: state.WorkflowID}, &state); err != nil {
+		// If workflow doesn't exist, there is nothing to reset
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Resetting Workflow Settings",
+			"Could not reset settings of workflow ID "+state.WorkflowID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *workflowSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using workflow ID
+	// Set both id and workflow_id to the imported value
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workflow_id"), req.ID)...)
+}
+
+// applySettings merges the configured settings into the workflow's current
+// settings. Settings managed in prior but no longer configured are removed.
+func (r *workflowSettingsResource) applySettings(plan, prior *workflowSettingsResourceModel) error {
+	workflow, err := r.client.GetWorkflow(plan.WorkflowID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	settings := workflow.Settings
+	if settings == nil {
+		settings = map[string]interface{}{}
+	}
+
+	desired := plan.settingsMap()
+	if prior != nil {
+		for key := range prior.settingsMap() {
+			if _, ok := desired[key]; !ok {
+				delete(settings, key)
+			}
+		}
+	}
+	for key, value := range desired {
+		settings[key] = value
+	}
+
+	// Send the workflow back unchanged apart from its settings
+	_, err = r.client.UpdateWorkflow(plan.WorkflowID.ValueString(), &client.Workflow{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    settings,
+	})
+	return err
+}
+
+// settingsMap returns the configured settings keyed by their n8n names.
+func (m *workflowSettingsResourceModel) settingsMap() map[string]interface{} {
+	settings := map[string]interface{}{}
+	strs := map[string]types.String{
+		"error_workflow":              m.ErrorWorkflow,
+		"timezone":                    m.Timezone,
+		"execution_order":             m.ExecutionOrder,
+		"save_data_error_execution":   m.SaveDataErrorExecution,
+		"save_data_success_execution": m.SaveDataSuccessExecution,
+		"caller_policy":               m.CallerPolicy,
+	}
+	for attr, value := range strs {
+		if !value.IsNull() {
+			settings[workflowSettingKeys[attr]] = value.ValueString()
+		}
+	}
+	if !m.SaveManualExecutions.IsNull() {
+		settings[workflowSettingKeys["save_manual_executions"]] = m.SaveManualExecutions.ValueBool()
+	}
+	if !m.SaveExecutionProgress.IsNull() {
+		settings[workflowSettingKeys["save_execution_progress"]] = m.SaveExecutionProgress.ValueBool()
+	}
+	if !m.ExecutionTimeout.IsNull() {
+		settings[workflowSettingKeys["execution_timeout"]] = m.ExecutionTimeout.ValueInt64()
+	}
+	return settings
+}
+
+// settingString reads a string setting, returning null when it is not set.
+func settingString(settings map[string]interface{}, key string) types.String {
+	if value, ok := settings[key].(string); ok {
+		return types.StringValue(value)
+	}
+	return types.StringNull()
+}
+
+// settingBool reads a boolean setting, returning null when it is not set.
+func settingBool(settings map[string]interface{}, key string) types.Bool {
+	if value, ok := settings[key].(bool); ok {
+		return types.BoolValue(value)
+	}
+	return types.BoolNull()
+}
+
+// settingInt64 reads a numeric setting, returning null when it is not set.
+func settingInt64(settings map[string]interface{}, key string) types.Int64 {
+	if value, ok := settings[key].(float64); ok {
+		return types.Int64Value(int64(value))
+	}
+	return types.Int64Null()
+}
@@ -0,0 +1,52 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccWorkflowSettingsResource(t *testing.T) {
+	// All settings are managed, as an import reads all of them
+	config := func(timezone string) string {
+		return testAccWorkflowConfig("tf-acc settings") + fmt.Sprintf(`
+resource "n8n_workflow_settings" "test" {
+  workflow_id = n8n_workflow.test.id
+
+  timezone                    = %q
+  execution_order             = "v1"
+  save_data_error_execution   = "all"
+  save_data_success_execution = "none"
+  save_manual_executions      = false
+  save_execution_progress     = false
+  execution_timeout           = 600
+  caller_policy               = "workflowsFromSameOwner"
+}
+`, timezone)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Europe/Berlin"),
+				Check:  resource.TestCheckResourceAttr("n8n_workflow_settings.test", "execution_order", "v1"),
+			},
+			{
+				Config:           config("America/New_York"),
+				ConfigPlanChecks: testAccExpectAction("n8n_workflow_settings.test", plancheck.ResourceActionUpdate),
+				Check:            resource.TestCheckResourceAttr("n8n_workflow_settings.test", "timezone", "America/New_York"),
+			},
+			{
+				ResourceName:      "n8n_workflow_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
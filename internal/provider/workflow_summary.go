@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Descriptions of the attributes summarizing the nodes of a workflow.
+const (
+	nodeCountDescription       = "Number of nodes in the workflow"
+	triggerTypesDescription    = "Node types of the trigger, poller, and webhook nodes of the workflow, including manual and error triggers"
+	usesCredentialsDescription = "IDs of the credentials the nodes of the workflow use, or their names for references without an ID"
+	hasWebhookDescription      = "Whether the workflow has a webhook or form trigger node"
+)
+
+// workflowNodeSummary returns the node count, trigger node types, credentials
+// used and whether there is a webhook node, so that policy checks can be
+// written in HCL without decoding the nodes JSON.
+func workflowNodeSummary(nodes []interface{}) (types.Int64, types.Set, types.Set, types.Bool) {
+	triggerTypes := map[string]bool{}
+	credentials := map[string]bool{}
+	hasWebhook := false
+
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		nodeType, _ := node["type"].(string)
+		if activeStartNodeTypes[nodeType] || strings.HasSuffix(nodeType, "Trigger") {
+			triggerTypes[nodeType] = true
+		}
+		if _, ok := webhookNodePrefixes[nodeType]; ok {
+			hasWebhook = true
+		}
+
+		references, _ := node["credentials"].(map[string]interface{})
+		for _, r := range references {
+			reference, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if id, _ := reference["id"].(string); id != "" {
+				credentials[id] = true
+			} else if name, _ := reference["name"].(string); name != "" {
+				credentials[name] = true
+			}
+		}
+	}
+
+	return types.Int64Value(int64(len(nodes))),
+		types.SetValueMust(types.StringType, stringValues(sortedKeys(triggerTypes))),
+		types.SetValueMust(types.StringType, stringValues(sortedKeys(credentials))),
+		types.BoolValue(hasWebhook)
+}
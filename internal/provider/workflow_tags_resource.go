@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workflowTagsResource{}
+	_ resource.ResourceWithConfigure   = &workflowTagsResource{}
+	_ resource.ResourceWithImportState = &workflowTagsResource{}
+	_ resource.ResourceWithIdentity    = &workflowTagsResource{}
+)
+
+// NewWorkflowTagsResource is a helper function to simplify the provider implementation.
+func NewWorkflowTagsResource() resource.Resource {
+	return &workflowTagsResource{}
+}
+
+// workflowTagsResource is the resource implementation.
+type workflowTagsResource struct {
+	client workflowTagsResourceClient
+}
+
+// workflowTagsResourceClient is the part of the n8n API the workflow tags resource uses.
+type workflowTagsResourceClient interface {
+	client.Endpoints
+	client.WorkflowsService
+}
+
+// workflowTagsResourceModel maps the resource schema data.
+type workflowTagsResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	WorkflowID types.String `tfsdk:"workflow_id"`
+	TagIDs     types.Set    `tfsdk:"tag_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *workflowTagsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_tags"
+}
+
+// Schema defines the schema for the resource.
+func (r *workflowTagsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the tags attached to an n8n workflow. This resource replaces all tags of the workflow, so do not also set tags on the n8n_workflow resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier (same as workflow_id)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				Description: "The ID of the workflow to tag",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag_ids": schema.SetAttribute{
+				Description: "IDs of the tags to attach. The tags must already exist in n8n.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *workflowTagsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("ID of the workflow whose tags are managed")
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workflowTagsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(workflowTagsResourceClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workflowTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowTagsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan workflowTagsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagIDs []string
+	resp.Diagnostics.Append(plan.TagIDs.ElementsAs(ctx, &tagIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.SetWorkflowTags(plan.WorkflowID.ValueString(), tagIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Tagging Workflow",
+			"Could not set tags of workflow ID "+plan.WorkflowID.ValueString()+" (hint: tags must exist in n8n before assigning them to workflows): "+err.Error(),
+		)
+		return
+	}
+
+	// Set the ID to the workflow ID
+	plan.ID = plan.WorkflowID
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workflowTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowTagsResource{client: withContext(r.client, ctx)}
+
+	// Get current state
+	var state workflowTagsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := r.client.GetWorkflowTags(state.WorkflowID.ValueString())
+	if err != nil {
+		// Check if the workflow was deleted outside of Terraform (404 error)
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Workflow Tags",
+			"Could not read tags of workflow ID "+state.WorkflowID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	tagIDs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	state.TagIDs, diags = types.SetValueFrom(ctx, types.StringType, tagIDs)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workflowTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowTagsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from plan
+	var plan workflowTagsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagIDs []string
+	resp.Diagnostics.Append(plan.TagIDs.ElementsAs(ctx, &tagIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.SetWorkflowTags(plan.WorkflowID.ValueString(), tagIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Tagging Workflow",
+			"Could not set tags of workflow ID "+plan.WorkflowID.ValueString()+" (hint: tags must exist in n8n before assigning them to workflows): "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workflowTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Cancel the requests of this operation together with it
+	r = &workflowTagsResource{client: withContext(r.client, ctx)}
+
+	// Retrieve values from state
+	var state workflowTagsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Detach all tags; the tags themselves are left in place
+	if _, err := r.client.SetWorkflowTags(state.WorkflowID.ValueString(), []string{}); err != nil {
+		// If workflow doesn't exist, there is nothing to detach
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing Workflow Tags",
+			"Could not remove tags of workflow ID "+state.WorkflowID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *workflowTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using workflow ID or identity
+	// Set both id and workflow_id to the imported value
+	id, diags := importedResourceID(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workflow_id"), id)...)
+}
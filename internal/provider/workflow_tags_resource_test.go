@@ -0,0 +1,62 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccWorkflowTagsResource(t *testing.T) {
+	first := testAccTag(t, "tf-acc-first")
+	second := testAccTag(t, "tf-acc-second")
+	config := func(tagIDs ...string) string {
+		return testAccWorkflowConfig("tf-acc tags") + fmt.Sprintf(`
+resource "n8n_workflow_tags" "test" {
+  workflow_id = n8n_workflow.test.id
+  tag_ids     = %s
+}
+`, hclStrings(tagIDs))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(first),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_workflow_tags.test", "id"),
+					resource.TestCheckResourceAttrPair("n8n_workflow_tags.test", "workflow_id", "n8n_workflow.test", "id"),
+					resource.TestCheckTypeSetElemAttr("n8n_workflow_tags.test", "tag_ids.*", first),
+				),
+			},
+			{
+				Config:           config(first, second),
+				ConfigPlanChecks: testAccExpectAction("n8n_workflow_tags.test", plancheck.ResourceActionUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow_tags.test", "tag_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("n8n_workflow_tags.test", "tag_ids.*", second),
+				),
+			},
+			{
+				ResourceName:      "n8n_workflow_tags.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// hclStrings formats values as an HCL list of strings
+func hclStrings(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
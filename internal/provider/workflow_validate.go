@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Trigger node types that start a workflow by hand or from another workflow,
+// so they are not enough to activate it.
+var inactiveTriggerNodeTypes = map[string]bool{
+	"n8n-nodes-base.manualTrigger":          true,
+	"n8n-nodes-base.errorTrigger":           true,
+	"n8n-nodes-base.executeWorkflowTrigger": true,
+}
+
+// Node types that can activate a workflow without a name ending in Trigger.
+var activeStartNodeTypes = map[string]bool{
+	"n8n-nodes-base.webhook":       true,
+	"n8n-nodes-base.emailReadImap": true,
+	"n8n-nodes-base.cron":          true,
+	"n8n-nodes-base.interval":      true,
+}
+
+// workflowDocument is a parsed workflow JSON document.
+type workflowDocument struct {
+	data        map[string]interface{}
+	name        string
+	nodes       []interface{}
+	connections map[string]interface{}
+}
+
+// parseWorkflowJSON parses a complete workflow JSON document and checks
+// that it has the fields every workflow needs. ValidateConfig reports the
+// problems at plan time; Create and Update check again once template
+// variables are substituted and unknown values are known.
+func parseWorkflowJSON(workflowJSON string) (*workflowDocument, []string) {
+	var doc workflowDocument
+	if err := json.Unmarshal([]byte(workflowJSON), &doc.data); err != nil {
+		return nil, []string{"Could not parse workflow_json: " + err.Error()}
+	}
+
+	var problems []string
+	var ok bool
+	if doc.name, ok = doc.data["name"].(string); !ok {
+		problems = append(problems, "workflow_json must contain a 'name' field")
+	}
+	if doc.nodes, ok = doc.data["nodes"].([]interface{}); !ok {
+		problems = append(problems, "workflow_json must contain a 'nodes' array")
+	}
+	if doc.connections, ok = doc.data["connections"].(map[string]interface{}); !ok {
+		problems = append(problems, "workflow_json must contain a 'connections' object")
+	}
+	if len(problems) > 0 {
+		return nil, problems
+	}
+	return &doc, nil
+}
+
+// workflowJSONProblems returns the problems with the structure of a
+// complete workflow JSON document.
+func workflowJSONProblems(workflowJSON string) []string {
+	doc, problems := parseWorkflowJSON(workflowJSON)
+	if len(problems) > 0 {
+		return problems
+	}
+
+	return workflowGraphProblems(doc.nodes, doc.connections)
+}
+
+// workflowGraphProblems returns the problems with the nodes and connections
+// of a workflow: duplicate node names and connections to or from nodes that
+// do not exist.
+func workflowGraphProblems(nodes []interface{}, connections map[string]interface{}) []string {
+	var problems []string
+
+	names := make(map[string]bool, len(nodes))
+	for i, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("node %d must be an object", i))
+			continue
+		}
+		name, ok := node["name"].(string)
+		if !ok || name == "" {
+			problems = append(problems, fmt.Sprintf("node %d must have a 'name'", i))
+			continue
+		}
+		if names[name] {
+			problems = append(problems, fmt.Sprintf("node names must be unique within a workflow; %q is used more than once", name))
+		}
+		names[name] = true
+	}
+
+	// connections is keyed by source node, then connection type, output index
+	// and the list of targets of that output
+	for _, source := range sortedKeys(connections) {
+		if !names[source] {
+			problems = append(problems, fmt.Sprintf("connections reference source node %q, which does not exist", source))
+		}
+
+		byType, _ := connections[source].(map[string]interface{})
+		for _, connectionType := range sortedKeys(byType) {
+			outputs, _ := byType[connectionType].([]interface{})
+			for _, output := range outputs {
+				targets, _ := output.([]interface{})
+				for _, t := range targets {
+					target, _ := t.(map[string]interface{})
+					if name, ok := target["node"].(string); ok && !names[name] {
+						problems = append(problems, fmt.Sprintf("connections reference target node %q from %q, which does not exist", name, source))
+					}
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// workflowTriggerNodes returns the names of the enabled trigger, poller and
+// webhook nodes that n8n listens on once the workflow is active.
+func workflowTriggerNodes(nodes []interface{}) []string {
+	var names []string
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if disabled, _ := node["disabled"].(bool); disabled {
+			continue
+		}
+
+		nodeType, _ := node["type"].(string)
+		if inactiveTriggerNodeTypes[nodeType] {
+			continue
+		}
+		if activeStartNodeTypes[nodeType] || strings.HasSuffix(nodeType, "Trigger") {
+			name, _ := node["name"].(string)
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// missingCredentialProblems returns a problem for every credential reference
+// of the nodes whose ID is not in known.
+func missingCredentialProblems(nodes []interface{}, known map[string]bool) []string {
+	var problems []string
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		nodeName, _ := node["name"].(string)
+
+		references, _ := node["credentials"].(map[string]interface{})
+		for _, credentialType := range sortedKeys(references) {
+			reference, _ := references[credentialType].(map[string]interface{})
+			id, _ := reference["id"].(string)
+			name, _ := reference["name"].(string)
+			if id == "" {
+				problems = append(problems, fmt.Sprintf("node %q references %s credential %q without an ID", nodeName, credentialType, name))
+				continue
+			}
+			if !known[id] {
+				problems = append(problems, fmt.Sprintf("node %q references %s credential %q (ID %s), which does not exist", nodeName, credentialType, name, id))
+			}
+		}
+	}
+	return problems
+}
+
+// unknownNodeTypeProblems returns a problem for every node whose type, or
+// type version, is not in installed, which maps node types to their versions.
+func unknownNodeTypeProblems(nodes []interface{}, installed map[string][]float64) []string {
+	var problems []string
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		nodeName, _ := node["name"].(string)
+		nodeType, _ := node["type"].(string)
+		if nodeType == "" {
+			continue
+		}
+
+		versions, ok := installed[nodeType]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("node %q has type %q, which is not installed on the instance", nodeName, nodeType))
+			continue
+		}
+
+		typeVersion, ok := node["typeVersion"].(float64)
+		if !ok || len(versions) == 0 {
+			continue
+		}
+		supported := false
+		for _, version := range versions {
+			if version == typeVersion {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			problems = append(problems, fmt.Sprintf("node %q has type %q version %g, but the instance supports versions %v", nodeName, nodeType, typeVersion, versions))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestWorkflowJSONProblems(t *testing.T) {
+	tests := map[string]struct {
+		workflowJSON string
+		want         []string
+	}{
+		"valid": {
+			workflowJSON: `{"name":"Orders","nodes":[{"name":"Start"},{"name":"Done"}],"connections":{"Start":{"main":[[{"node":"Done","type":"main","index":0}]]}}}`,
+		},
+		"not json": {
+			workflowJSON: `{"name":`,
+			want:         []string{"Could not parse workflow_json: unexpected end of JSON input"},
+		},
+		"empty object": {
+			workflowJSON: `{}`,
+			want: []string{
+				"workflow_json must contain a 'name' field",
+				"workflow_json must contain a 'nodes' array",
+				"workflow_json must contain a 'connections' object",
+			},
+		},
+		"nodes not an array": {
+			workflowJSON: `{"name":"Orders","nodes":{},"connections":{}}`,
+			want:         []string{"workflow_json must contain a 'nodes' array"},
+		},
+		"graph problems": {
+			workflowJSON: `{"name":"Orders","nodes":[{"name":"Start"}],"connections":{"Start":{"main":[[{"node":"Missing"}]]}}}`,
+			want:         []string{`connections reference target node "Missing" from "Start", which does not exist`},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := workflowJSONProblems(tt.workflowJSON)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("workflowJSONProblems() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWorkflowJSON(t *testing.T) {
+	doc, problems := parseWorkflowJSON(`{"name":"Orders","active":true,"nodes":[{"name":"Start"}],"connections":{},"settings":{"timezone":"UTC"}}`)
+	if len(problems) > 0 {
+		t.Fatalf("parseWorkflowJSON() problems = %q", problems)
+	}
+	if doc.name != "Orders" || len(doc.nodes) != 1 || doc.connections == nil {
+		t.Errorf("parseWorkflowJSON() = %+v", doc)
+	}
+	if doc.data["settings"] == nil || doc.data["active"] != true {
+		t.Errorf("parseWorkflowJSON() dropped optional fields: %+v", doc.data)
+	}
+}
+
+func TestWorkflowGraphProblems(t *testing.T) {
+	tests := map[string]struct {
+		nodes       string
+		connections string
+		want        []string
+	}{
+		"no nodes": {
+			nodes:       `[]`,
+			connections: `{}`,
+		},
+		"connected": {
+			nodes:       `[{"name":"If"},{"name":"Yes"},{"name":"No"}]`,
+			connections: `{"If":{"main":[[{"node":"Yes"}],[{"node":"No"}]]}}`,
+		},
+		"node not an object": {
+			nodes:       `["Start"]`,
+			connections: `{}`,
+			want:        []string{"node 0 must be an object"},
+		},
+		"node without name": {
+			nodes:       `[{"name":"Start"},{"type":"n8n-nodes-base.noOp"}]`,
+			connections: `{}`,
+			want:        []string{"node 1 must have a 'name'"},
+		},
+		"duplicate names": {
+			nodes:       `[{"name":"Start"},{"name":"Start"}]`,
+			connections: `{}`,
+			want:        []string{`node names must be unique within a workflow; "Start" is used more than once`},
+		},
+		"missing source": {
+			nodes:       `[{"name":"Start"}]`,
+			connections: `{"Gone":{"main":[[{"node":"Start"}]]}}`,
+			want:        []string{`connections reference source node "Gone", which does not exist`},
+		},
+		"missing targets are reported in order": {
+			nodes:       `[{"name":"B"},{"name":"A"}]`,
+			connections: `{"B":{"main":[[{"node":"Y"}]]},"A":{"ai_tool":[[{"node":"X"}]]}}`,
+			want: []string{
+				`connections reference target node "X" from "A", which does not exist`,
+				`connections reference target node "Y" from "B", which does not exist`,
+			},
+		},
+		"malformed outputs are ignored": {
+			nodes:       `[{"name":"Start"}]`,
+			connections: `{"Start":{"main":"none"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var nodes []interface{}
+			var connections map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.nodes), &nodes); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.connections), &connections); err != nil {
+				t.Fatal(err)
+			}
+
+			got := workflowGraphProblems(nodes, connections)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("workflowGraphProblems() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// validationWorkflowPrefix marks the temporary workflows and projects created during validation.
+const validationWorkflowPrefix = "[terraform validation] "
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workflowValidationDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowValidationDataSource{}
+)
+
+// NewWorkflowValidationDataSource is a helper function to simplify the provider implementation.
+func NewWorkflowValidationDataSource() datasource.DataSource {
+	return &workflowValidationDataSource{}
+}
+
+// workflowValidationDataSource is the data source implementation.
+type workflowValidationDataSource struct {
+	client workflowValidationClient
+}
+
+// workflowValidationClient is the part of the n8n API the workflow validation data source uses.
+type workflowValidationClient interface {
+	client.ProjectsService
+	client.WorkflowsService
+}
+
+// workflowValidationDataSourceModel maps the data source schema data.
+type workflowValidationDataSourceModel struct {
+	WorkflowJSON    types.String `tfsdk:"workflow_json"`
+	CheckActivation types.Bool   `tfsdk:"check_activation"`
+	FailOnError     types.Bool   `tfsdk:"fail_on_error"`
+	Valid           types.Bool   `tfsdk:"valid"`
+	Errors          types.List   `tfsdk:"errors"`
+}
+
+// Metadata returns the data source type name.
+func (d *workflowValidationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_validation"
+}
+
+// Schema defines the schema for the data source.
+func (d *workflowValidationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Validates a workflow definition against the n8n instance without keeping it. " +
+			"The workflow is created as an inactive copy in a temporary project, activated only when check_activation is true, and always deleted again together with the project, so server-side errors are reported during plan. " +
+			"Instances whose license has no team projects get the copy in the personal project of the API key owner.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_json": schema.StringAttribute{
+				Description: "Complete workflow JSON to validate, in the same format as the workflow_json attribute of n8n_workflow",
+				Required:    true,
+			},
+			"check_activation": schema.BoolAttribute{
+				Description: "Also try to activate the temporary copy, which catches missing trigger nodes and broken credentials. " +
+					"Activation registers webhooks, so this fails for workflows whose webhook paths are already used by an active workflow, and credentials must be usable from the temporary project. Defaults to false.",
+				Optional: true,
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Description: "Report validation errors as Terraform errors instead of only exposing them through the valid and errors attributes. Defaults to false.",
+				Optional:    true,
+			},
+			"valid": schema.BoolAttribute{
+				Description: "Whether the workflow passed validation",
+				Computed:    true,
+			},
+			"errors": schema.ListAttribute{
+				Description: "Validation errors reported locally or by the n8n instance",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workflowValidationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(workflowValidationClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workflowValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &workflowValidationDataSource{client: withContext(d.client, ctx)}
+
+	var state workflowValidationDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validationErrors, err := d.validate(state.WorkflowJSON.ValueString(), state.CheckActivation.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Validating n8n Workflow",
+			err.Error(),
+		)
+		return
+	}
+
+	if state.FailOnError.ValueBool() {
+		for _, validationError := range validationErrors {
+			resp.Diagnostics.AddError("Invalid n8n Workflow", validationError)
+		}
+	}
+
+	state.Valid = types.BoolValue(len(validationErrors) == 0)
+	errorList, diags := types.ListValueFrom(ctx, types.StringType, validationErrors)
+	resp.Diagnostics.Append(diags...)
+	state.Errors = errorList
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// validate returns the problems found with the workflow definition. The
+// returned error is reserved for failures that are not about the workflow
+// itself, such as being unable to clean up the temporary copy.
+func (d *workflowValidationDataSource) validate(workflowJSON string, checkActivation bool) ([]string, error) {
+	doc, problems := parseWorkflowJSON(workflowJSON)
+	if len(problems) > 0 {
+		return problems, nil
+	}
+
+	settings, _ := doc.data["settings"].(map[string]interface{})
+
+	// Keep the temporary copy apart from the workflows of the instance.
+	// Instances whose license has no team projects get it in the personal
+	// project of the API key owner instead.
+	var projectID string
+	project, err := d.client.CreateProject(validationWorkflowPrefix + doc.name)
+	switch {
+	case err == nil:
+		projectID = project.ID
+	case !client.IsLicenseRequired(err):
+		return nil, fmt.Errorf("could not create a temporary project for the validation: %w", err)
+	}
+
+	validationErrors, err := d.validateCopy(doc, settings, projectID, checkActivation)
+
+	if projectID != "" {
+		if deleteErr := d.client.DeleteProject(projectID); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("could not delete temporary validation project ID %s, please delete it manually: %w", projectID, deleteErr))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return validationErrors, nil
+}
+
+// validateCopy submits a temporary copy of the workflow so the server runs
+// its own validation, and deletes it again.
+func (d *workflowValidationDataSource) validateCopy(doc *workflowDocument, settings map[string]interface{}, projectID string, checkActivation bool) ([]string, error) {
+	created, err := d.client.CreateWorkflow(&client.Workflow{
+		Name:        validationWorkflowPrefix + doc.name,
+		Nodes:       doc.nodes,
+		Connections: doc.connections,
+		Settings:    settings,
+		ProjectID:   projectID,
+	})
+	if err != nil {
+		return []string{"n8n rejected the workflow: " + err.Error()}, nil
+	}
+
+	// Activation registers webhooks and triggers, so the copy stays
+	// inactive unless activation was asked for
+	var validationErrors []string
+	if checkActivation {
+		if _, err := d.client.ActivateWorkflow(created.ID); err != nil {
+			validationErrors = append(validationErrors, "n8n could not activate the workflow: "+err.Error())
+		} else if _, err := d.client.DeactivateWorkflow(created.ID); err != nil {
+			validationErrors = append(validationErrors, "n8n could not deactivate the temporary workflow: "+err.Error())
+		}
+	}
+
+	if err := deleteWorkflow(d.client, created.ID); err != nil {
+		return nil, fmt.Errorf("could not delete temporary validation workflow ID %s, please delete it manually: %w", created.ID, err)
+	}
+
+	return validationErrors, nil
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+func TestWorkflowValidationDataSourceValidate(t *testing.T) {
+	const workflowJSON = `{"name":"Orders","nodes":[{"name":"Start","type":"n8n-nodes-base.manualTrigger"}],"connections":{}}`
+
+	tests := map[string]struct {
+		checkActivation bool
+		projects        bool
+		want            []string
+	}{
+		"temporary project": {
+			projects: true,
+			want: []string{
+				"POST /api/v1/projects",
+				"POST /api/v1/workflows projectId=p1",
+				"DELETE /api/v1/workflows/w1",
+				"GET /api/v1/workflows/w1",
+				"DELETE /api/v1/workflows/w1",
+				"DELETE /api/v1/projects/p1",
+			},
+		},
+		"activation": {
+			checkActivation: true,
+			projects:        true,
+			want: []string{
+				"POST /api/v1/projects",
+				"POST /api/v1/workflows projectId=p1",
+				"POST /api/v1/workflows/w1/activate",
+				"POST /api/v1/workflows/w1/deactivate",
+				"DELETE /api/v1/workflows/w1",
+				"GET /api/v1/workflows/w1",
+				"DELETE /api/v1/workflows/w1",
+				"DELETE /api/v1/projects/p1",
+			},
+		},
+		"no team projects": {
+			want: []string{
+				"POST /api/v1/projects",
+				"POST /api/v1/workflows",
+				"DELETE /api/v1/workflows/w1",
+				"GET /api/v1/workflows/w1",
+				"DELETE /api/v1/workflows/w1",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			deleted := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				request := r.Method + " " + r.URL.Path
+				var body map[string]interface{}
+				if data, err := io.ReadAll(r.Body); err == nil && len(data) > 0 {
+					if err := json.Unmarshal(data, &body); err != nil {
+						t.Error(err)
+					}
+				}
+				if projectID, ok := body["projectId"].(string); ok && r.URL.Path == "/api/v1/workflows" {
+					request += " projectId=" + projectID
+				}
+				requests = append(requests, request)
+
+				w.Header().Set("Content-Type", "application/json")
+				response := `{"id":"w1","name":"[terraform validation] Orders","nodes":[],"connections":{}}`
+				switch {
+				case request == "POST /api/v1/projects" && !tt.projects:
+					w.WriteHeader(http.StatusForbidden)
+					response = `{"message":"Plan lacks license for this feature"}`
+				case request == "POST /api/v1/projects":
+					response = `{"id":"p1","name":"[terraform validation] Orders","type":"team"}`
+				case r.Method == http.MethodDelete && !deleted && r.URL.Path == "/api/v1/workflows/w1":
+					deleted = true
+				case r.Method == http.MethodGet && deleted:
+					response = `{"id":"w1","name":"[terraform validation] Orders","nodes":[],"connections":{},"isArchived":true}`
+				}
+				if _, err := w.Write([]byte(response)); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer server.Close()
+
+			d := &workflowValidationDataSource{client: client.NewClient(server.URL, "key")}
+			validationErrors, err := d.validate(workflowJSON, tt.checkActivation)
+			if err != nil {
+				t.Fatalf("validate() error = %v", err)
+			}
+			if len(validationErrors) > 0 {
+				t.Errorf("validate() = %q, want no errors", validationErrors)
+			}
+			if !reflect.DeepEqual(requests, tt.want) {
+				t.Errorf("requests = %q, want %q", requests, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pinotelio/terraform-provider-n8n/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workflowsDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowsDataSource{}
+)
+
+// NewWorkflowsDataSource is a helper function to simplify the provider implementation.
+func NewWorkflowsDataSource() datasource.DataSource {
+	return &workflowsDataSource{}
+}
+
+// workflowsDataSource is the data source implementation.
+type workflowsDataSource struct {
+	client client.WorkflowsService
+}
+
+// workflowsDataSourceModel maps the data source schema data.
+type workflowsDataSourceModel struct {
+	Active    types.Bool             `tfsdk:"active"`
+	Name      types.String           `tfsdk:"name"`
+	ProjectID types.String           `tfsdk:"project_id"`
+	Tags      types.Set              `tfsdk:"tags"`
+	Workflows []workflowSummaryModel `tfsdk:"workflows"`
+}
+
+// workflowSummaryModel maps a single workflow of the list.
+type workflowSummaryModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Active    types.Bool   `tfsdk:"active"`
+	ProjectID types.String `tfsdk:"project_id"`
+	FolderID  types.String `tfsdk:"folder_id"`
+	Tags      types.Set    `tfsdk:"tags"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *workflowsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflows"
+}
+
+// Schema defines the schema for the data source.
+func (d *workflowsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists n8n workflows, optionally filtered by status, name, project or tags.",
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) workflows",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return workflows with this name",
+				Optional:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Only return workflows of this project",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Only return workflows that have all of these tag names",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"workflows": schema.ListNestedAttribute{
+				Description: "Workflows matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Workflow identifier",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the workflow",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the workflow is active",
+							Computed:    true,
+						},
+						"project_id": schema.StringAttribute{
+							Description: "ID of the project that owns the workflow, if reported by n8n",
+							Computed:    true,
+						},
+						"folder_id": schema.StringAttribute{
+							Description: "ID of the folder the workflow is in, if any",
+							Computed:    true,
+						},
+						"tags": schema.SetAttribute{
+							Description: "Names of the workflow's tags",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the workflow was created",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Timestamp when the workflow was last updated",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workflowsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.WorkflowsService)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an n8n API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Cancel the requests of this operation together with it
+	d = &workflowsDataSource{client: withContext(d.client, ctx)}
+
+	var state workflowsDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := client.WorkflowFilter{
+		Active:    state.Active.ValueBoolPointer(),
+		Name:      state.Name.ValueString(),
+		ProjectID: state.ProjectID.ValueString(),
+	}
+	if !state.Tags.IsNull() {
+		resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &filter.Tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	workflows, err := d.client.SearchWorkflows(filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading n8n Workflows",
+			"Could not list n8n workflows: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.Workflows = make([]workflowSummaryModel, 0, len(workflows))
+	for _, workflow := range workflows {
+		tagNames := make([]string, 0, len(workflow.Tags))
+		for _, tag := range workflow.Tags {
+			tagNames = append(tagNames, tag["name"])
+		}
+		tags, diags := types.SetValueFrom(ctx, types.StringType, tagNames)
+		resp.Diagnostics.Append(diags...)
+
+		state.Workflows = append(state.Workflows, workflowSummaryModel{
+			ID:        types.StringValue(workflow.ID),
+			Name:      types.StringValue(workflow.Name),
+			Active:    types.BoolValue(workflow.Active),
+			ProjectID: types.StringValue(workflow.OwnerProjectID()),
+			FolderID:  types.StringValue(workflow.ParentFolderID),
+			Tags:      tags,
+			CreatedAt: types.StringValue(workflow.CreatedAt),
+			UpdatedAt: types.StringValue(workflow.UpdatedAt),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,385 @@
+// Package vcr records the responses of a live n8n instance to cassette files
+// and replays them, so that the client and the resources can be tested
+// against realistic responses without a running instance.
+//
+// Tests get an httptest server from Start and use its URL as the n8n
+// endpoint. By default the server answers from the cassette. With
+// N8N_VCR_MODE=record, it forwards the requests to the instance at
+// N8N_ENDPOINT with the key in N8N_API_KEY and writes the cassette when the
+// test ends.
+//
+// Cassettes are kept per n8n version, e.g.
+// testdata/cassettes/1.90.0/workflow_create.json, so that the same test can be
+// replayed against the responses of every version it was recorded with. Run
+// replays a test for each of them, and records a new one under the version
+// in N8N_VCR_VERSION.
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// ModeEnv selects whether Start records or replays
+const ModeEnv = "N8N_VCR_MODE"
+
+// VersionEnv names the n8n version a recorded cassette is stored under
+const VersionEnv = "N8N_VCR_VERSION"
+
+// Mode is how a server answers requests
+type Mode string
+
+const (
+	// ModeReplay answers requests from the cassette
+	ModeReplay Mode = "replay"
+	// ModeRecord forwards requests to a live instance and records them
+	ModeRecord Mode = "record"
+)
+
+// redacted replaces secrets in recorded bodies
+const redacted = "REDACTED"
+
+// sensitiveKeyParts mark the JSON keys whose values are not recorded. A key
+// is sensitive when its lowercased name contains one of them, which covers
+// the fields of credential data such as clientSecret or accessToken.
+var sensitiveKeyParts = []string{
+	"password",
+	"apikey",
+	"token",
+	"secret",
+	"privatekey",
+	"passphrase",
+	"authorization",
+	"cookie",
+}
+
+// Cassette is the recorded exchange of a test with n8n
+type Cassette struct {
+	// N8NVersion is the version of the instance the cassette was recorded
+	// with, if it reported one
+	N8NVersion   string        `json:"n8nVersion,omitempty"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response n8n sent to it
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. Headers are not recorded, so that
+// credentials never end up in a cassette.
+type Request struct {
+	Method string          `json:"method"`
+	URI    string          `json:"uri"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	Status int               `json:"status"`
+	Header map[string]string `json:"header,omitempty"`
+	Body   json.RawMessage   `json:"body,omitempty"`
+}
+
+// recordedHeaders are the response headers kept in cassettes
+var recordedHeaders = []string{"Content-Type", "Retry-After"}
+
+// Server serves a cassette, or records one
+type Server struct {
+	*httptest.Server
+
+	t        testing.TB
+	path     string
+	mode     Mode
+	upstream string
+	apiKey   string
+
+	mu       sync.Mutex
+	cassette Cassette
+	next     int
+}
+
+// Start starts a server for the cassette at path in the mode selected by
+// ModeEnv. It is closed, and a recorded cassette written, when the test ends.
+func Start(t testing.TB, path string) *Server {
+	t.Helper()
+
+	s := &Server{t: t, path: path, mode: ModeReplay}
+	if Mode(os.Getenv(ModeEnv)) == ModeRecord {
+		s.mode = ModeRecord
+		s.upstream = strings.TrimSuffix(os.Getenv("N8N_ENDPOINT"), "/")
+		s.apiKey = os.Getenv("N8N_API_KEY")
+		if s.upstream == "" {
+			t.Fatalf("%s=record requires N8N_ENDPOINT", ModeEnv)
+		}
+	} else {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("could not read cassette: %v (record it with %s=record)", err, ModeEnv)
+		}
+		if err := json.Unmarshal(content, &s.cassette); err != nil {
+			t.Fatalf("could not parse cassette %s: %v", path, err)
+		}
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.finish)
+	return s
+}
+
+// Versions returns the n8n versions that have a cassette with the given
+// file name under dir, e.g. testdata/cassettes
+func Versions(dir, name string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, entry.Name(), name)); err == nil {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// Run runs test as a subtest for each n8n version that has a cassette with
+// the given file name under dir, with a server that replays it. When
+// recording, it runs test once and stores the cassette under the version in
+// VersionEnv.
+func Run(t *testing.T, dir, name string, test func(t *testing.T, s *Server)) {
+	t.Helper()
+
+	versions := Versions(dir, name)
+	if Mode(os.Getenv(ModeEnv)) == ModeRecord {
+		version := os.Getenv(VersionEnv)
+		if version == "" {
+			t.Fatalf("%s=record requires %s", ModeEnv, VersionEnv)
+		}
+		versions = []string{version}
+	}
+	if len(versions) == 0 {
+		t.Fatalf("no cassette %s under %s (record it with %s=record)", name, dir, ModeEnv)
+	}
+
+	for _, version := range versions {
+		t.Run(version, func(t *testing.T) {
+			test(t, Start(t, filepath.Join(dir, version, name)))
+		})
+	}
+}
+
+// serveHTTP answers a request in the mode of the server
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var response Response
+	if s.mode == ModeRecord {
+		response, err = s.record(r, body)
+	} else {
+		response, err = s.replay(r, body)
+	}
+	if err != nil {
+		s.t.Errorf("vcr: %v", err)
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return
+	}
+
+	for name, value := range response.Header {
+		w.Header().Set(name, value)
+	}
+	w.WriteHeader(response.Status)
+	_, _ = w.Write(response.Body)
+}
+
+// replay answers a request with the next interaction of the cassette, which
+// must be for the same method and URI
+func (s *Server) replay(r *http.Request, body []byte) (Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.next >= len(s.cassette.Interactions) {
+		return Response{}, fmt.Errorf("unexpected request %s %s: all %d interactions of %s were replayed",
+			r.Method, r.URL.RequestURI(), len(s.cassette.Interactions), s.path)
+	}
+
+	interaction := s.cassette.Interactions[s.next]
+	if interaction.Request.Method != r.Method || interaction.Request.URI != r.URL.RequestURI() {
+		return Response{}, fmt.Errorf("request %d is %s %s, but %s recorded %s %s",
+			s.next+1, r.Method, r.URL.RequestURI(), s.path, interaction.Request.Method, interaction.Request.URI)
+	}
+	if len(interaction.Request.Body) > 0 && !jsonEqual(interaction.Request.Body, redactJSON(body)) {
+		return Response{}, fmt.Errorf("request %d %s %s has a different body than recorded in %s:\n%s",
+			s.next+1, r.Method, r.URL.RequestURI(), s.path, body)
+	}
+
+	s.next++
+	return interaction.Response, nil
+}
+
+// record forwards a request to the live instance and records it
+func (s *Server) record(r *http.Request, body []byte) (Response, error) {
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, s.upstream+r.URL.RequestURI(), bytes.NewReader(body))
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header = r.Header.Clone()
+	if s.apiKey != "" {
+		req.Header.Set("X-N8N-API-KEY", s.apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Response{}, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Response{}, err
+	}
+
+	response := Response{Status: resp.StatusCode, Header: map[string]string{}}
+	for _, name := range recordedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			response.Header[name] = value
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cassette.N8NVersion == "" {
+		s.cassette.N8NVersion = resp.Header.Get("X-N8N-Version")
+	}
+	// The response is replayed as recorded, except for its secrets
+	recorded := response
+	recorded.Body = redactJSON(respBody)
+	s.cassette.Interactions = append(s.cassette.Interactions, Interaction{
+		Request:  Request{Method: r.Method, URI: r.URL.RequestURI(), Body: redactJSON(body)},
+		Response: recorded,
+	})
+
+	response.Body = respBody
+	return response, nil
+}
+
+// finish closes the server and writes a recorded cassette, or checks that a
+// replayed one was used up
+func (s *Server) finish() {
+	s.Close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mode == ModeReplay {
+		if s.next < len(s.cassette.Interactions) && !s.t.Failed() {
+			s.t.Errorf("vcr: only %d of %d interactions of %s were replayed", s.next, len(s.cassette.Interactions), s.path)
+		}
+		return
+	}
+
+	content, err := json.MarshalIndent(s.cassette, "", "  ")
+	if err != nil {
+		s.t.Errorf("vcr: could not encode cassette: %v", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		s.t.Errorf("vcr: could not create cassette directory: %v", err)
+		return
+	}
+	if err := os.WriteFile(s.path, append(content, '\n'), 0o600); err != nil {
+		s.t.Errorf("vcr: could not write cassette: %v", err)
+	}
+}
+
+// redactJSON replaces the values of sensitive keys in a JSON body. Bodies
+// that are not JSON are recorded as a JSON string.
+func redactJSON(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var parsed interface{}
+	if json.Unmarshal(body, &parsed) != nil {
+		encoded, err := json.Marshal(string(body))
+		if err != nil {
+			return nil
+		}
+		return encoded
+	}
+
+	encoded, err := json.Marshal(redactValue(parsed, false))
+	if err != nil {
+		return nil
+	}
+	return encoded
+}
+
+// redactValue replaces the values of sensitive keys in a decoded JSON value.
+// Objects and arrays keep their shape, so that a replayed response decodes
+// like the recorded one; only the strings, numbers and booleans under a
+// sensitive key are replaced.
+func redactValue(value interface{}, sensitive bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = redactValue(item, sensitive || isSensitiveKey(key))
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, sensitive)
+		}
+	case nil:
+	default:
+		if sensitive {
+			return redacted
+		}
+	}
+	return value
+}
+
+// isSensitiveKey reports whether the value of a JSON key is a secret
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonEqual reports whether two JSON documents are equal regardless of the
+// order of their keys
+func jsonEqual(a, b json.RawMessage) bool {
+	var decodedA, decodedB interface{}
+	if json.Unmarshal(a, &decodedA) != nil || json.Unmarshal(b, &decodedB) != nil {
+		return bytes.Equal(a, b)
+	}
+	encodedA, errA := json.Marshal(decodedA)
+	encodedB, errB := json.Marshal(decodedB)
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
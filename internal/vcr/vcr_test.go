@@ -0,0 +1,42 @@
+package vcr
+
+import (
+	"testing"
+)
+
+func TestRedactJSON(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		want string
+	}{
+		"empty": {},
+		"not json": {
+			body: "Bad Gateway",
+			want: `"Bad Gateway"`,
+		},
+		"credential data keeps its shape": {
+			body: `{"name":"Mail","type":"smtp","data":{"user":"ops","password":"hunter2","port":465,"secure":true}}`,
+			want: `{"data":{"password":"REDACTED","port":465,"secure":true,"user":"ops"},"name":"Mail","type":"smtp"}`,
+		},
+		"nested secrets": {
+			body: `{"data":{"oauthTokenData":{"access_token":"a","expires_in":3600},"clientSecret":"s","scopes":["read"]}}`,
+			want: `{"data":{"clientSecret":"REDACTED","oauthTokenData":{"access_token":"REDACTED","expires_in":"REDACTED"},"scopes":["read"]}}`,
+		},
+		"lists": {
+			body: `{"data":[{"id":"1","apiKey":"n8n_api_1"},{"id":"2","apiKey":null}],"nextCursor":null}`,
+			want: `{"data":[{"apiKey":"REDACTED","id":"1"},{"apiKey":null,"id":"2"}],"nextCursor":null}`,
+		},
+		"workflow": {
+			body: `{"name":"Orders","nodes":[{"name":"Webhook","parameters":{"path":"orders"}}],"connections":{}}`,
+			want: `{"connections":{},"name":"Orders","nodes":[{"name":"Webhook","parameters":{"path":"orders"}}]}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := string(redactJSON([]byte(tt.body))); got != tt.want {
+				t.Errorf("redactJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}